@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var activityFormats = []report.Format{report.FormatText, report.FormatMarkdown}
+
+func newActivityCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		user     string
+		rangeArg string
+		format   string
+		dr       *utils.DayRange
+		f        report.Format
+	)
+	const (
+		flagRange  = "range"
+		flagFormat = "format"
+	)
+	c := &cobra.Command{
+		Use:   "activity [user]",
+		Short: "List issues a user created, commented on or resolved",
+		Example: `
+To see what you did in the last week:
+
+   activity
+
+To see what jdoe did in September, as markdown for a status doc:
+
+   activity jdoe --` + flagRange + ` 2026-Sep-01:2026-Sep-30 --` + flagFormat + ` markdown
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) > 1 {
+				return fmt.Errorf("specify at most one user")
+			}
+			if len(args) == 1 {
+				user = args[0]
+			}
+			if rangeArg == "" {
+				dr, err = utils.MakeDayRangeSimple(utils.Today().AddDays(-6), 7)
+			} else {
+				dr, err = utils.MakeRangeFromStringPair(rangeArg)
+			}
+			if err != nil {
+				return fmt.Errorf("invalid --%s %q; %w", flagRange, rangeArg, err)
+			}
+			f, err = report.ParseFormat(format, activityFormats...)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) (err error) {
+			if user == "" {
+				if user, err = jb.GetMyself(); err != nil {
+					return err
+				}
+			}
+			var a *myj.Activity
+			if a, err = jb.GetActivity(user, dr); err != nil {
+				return err
+			}
+			report.DoActivity(os.Stdout, a, f)
+			return nil
+		},
+	}
+	c.Flags().StringVar(&rangeArg, flagRange, "",
+		"date range like 2026-Sep-01:2026-Sep-30 (default is the last seven days)")
+	c.Flags().StringVar(&format, flagFormat, report.FormatText.String(),
+		"output format, one of "+report.FormatNames(activityFormats...))
+	return c
+}
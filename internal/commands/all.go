@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/monopole/gojira/internal/commands/comment"
 	"github.com/monopole/gojira/internal/commands/epic"
+	"github.com/monopole/gojira/internal/commands/reports"
 	"github.com/monopole/gojira/internal/commands/set"
+	"github.com/monopole/gojira/internal/commands/sprint"
 	"github.com/monopole/gojira/internal/myhttp"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
@@ -73,6 +76,17 @@ func NewGoJiraCommand() *cobra.Command {
 		epic.NewEpicCmd(&jb),
 		newPrintCmd(&jb),
 		newBlockCmd(&jb),
+		newLinkCmd(&jb),
+		sprint.NewSprintCmd(&jb),
+		newActivityCmd(&jb),
+		newHistoryCmd(&jb),
+		newForecastCmd(&jb),
+		newLintCmd(&jb),
+		newQueryCmd(&jb),
+		comment.NewCommentCmd(&jb),
+		newTuiCmd(&jb),
+		newUndoCmd(&jb, &jiraArgs),
+		reports.NewReportCmd(&jb),
 	)
 	func(set *pflag.FlagSet) {
 		set.StringVarP(&jiraArgs.Project, "project", "p", "",
@@ -84,6 +98,15 @@ func NewGoJiraCommand() *cobra.Command {
 				envJiraToken))
 	}(c.PersistentFlags())
 
+	c.PersistentFlags().StringSliceVar(
+		&jiraArgs.DependencyLinkTypes, "dependency-links", []string{myj.LinkTypeBlocks},
+		"link types that make one epic depend on another, in epic graphs")
+	c.PersistentFlags().BoolVar(
+		&jiraArgs.DryRun, "dry-run", false,
+		"print the writes that would be made, rather than make them")
+	c.PersistentFlags().StringVar(
+		&jiraArgs.JournalPath, "journal", myj.DefaultJournalPath(),
+		"file recording writes so they can be undone; empty to not record")
 	utils.FlagsAddDebug(c.PersistentFlags())
 	c.PersistentFlags().StringVar(
 		&caPath, "ca-path", "", "local path to CA cert file for TLS checking")
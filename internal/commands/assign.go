@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+
+	"github.com/monopole/gojira/internal/commands/selection"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
 	"github.com/spf13/cobra"
@@ -12,6 +14,7 @@ func newAssignCmd(jb *myj.JiraBoss) *cobra.Command {
 		ldap   string
 		issues []int
 		remove bool
+		sel    = selection.New(false)
 	)
 	c := &cobra.Command{
 		Use:   "assign {userName} {issueNum}...",
@@ -24,14 +27,16 @@ To assign issues 100, 200 and 300 to bob:
 To remove assignments from those issues:
 
    assign -r 100 200 300
+
+` + selection.Usage + `
 `,
 		Args: func(_ *cobra.Command, args []string) (err error) {
 			if remove {
-				if len(args) < 1 {
+				if len(args) < 1 && !sel.IsSet() {
 					return fmt.Errorf("specify at least one issue")
 				}
 			} else {
-				if len(args) < 2 {
+				if len(args) < 2 && !(len(args) == 1 && sel.IsSet()) {
 					return fmt.Errorf("specify a user and at least one issue")
 				}
 				ldap = args[0]
@@ -42,10 +47,14 @@ To remove assignments from those issues:
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) (err error) {
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
 			return jb.AssignIssues(issues, ldap)
 		},
 	}
 	c.Flags().BoolVarP(
 		&remove, "remove", "r", false, "remove the label instead of add the label")
+	sel.AddFlags(c.Flags())
 	return c
 }
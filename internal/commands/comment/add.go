@@ -0,0 +1,63 @@
+package comment
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newAddCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		issues []int
+		text   string
+	)
+	const flagIssues = "issues"
+	c := &cobra.Command{
+		Use:   "add {issueNum} [text]",
+		Short: "Add a comment to an issue",
+		Long: `Add a comment to an issue.
+
+If the text is "-" it's read from stdin.  If it's missing, your
+editor ($VISUAL or $EDITOR) opens to write it.`,
+		Example: `
+   comment add 99 "Waiting on the API review."
+   git log -1 --format=%B | comment add 99 -
+   comment add 99
+   comment add --` + flagIssues + ` 99,100,101 "Moved to Q3"
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(issues) == 0 {
+				if len(args) < 1 {
+					return fmt.Errorf("specify an issue number or --%s", flagIssues)
+				}
+				var n int
+				if n, err = strconv.Atoi(args[0]); err != nil {
+					return fmt.Errorf("%q is not a number", args[0])
+				}
+				issues = []int{n}
+				args = args[1:]
+			}
+			if len(args) > 1 {
+				return fmt.Errorf("put the comment text in quotes")
+			}
+			if len(args) == 1 {
+				text = args[0]
+			}
+			return nil
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			body, err := utils.ReadText(text, "")
+			if err != nil {
+				return err
+			}
+			return jb.AddComment(issues, body)
+		},
+	}
+	c.Flags().IntSliceVar(&issues, flagIssues, nil,
+		"comma separated issue numbers, to comment on many issues at once")
+	return c
+}
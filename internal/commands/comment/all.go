@@ -0,0 +1,21 @@
+package comment
+
+import (
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/spf13/cobra"
+)
+
+func NewCommentCmd(jb *myj.JiraBoss) *cobra.Command {
+	c := &cobra.Command{
+		Use:          "comment",
+		Short:        "Add, list, edit and delete comments on issues",
+		SilenceUsage: true,
+	}
+	c.AddCommand(
+		newAddCmd(jb),
+		newListCmd(jb),
+		newEditCmd(jb),
+		newDeleteCmd(jb),
+	)
+	return c
+}
@@ -0,0 +1,96 @@
+package comment
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// issueAndComment parses the leading {issueNum} {commentId} args.
+func issueAndComment(args []string) (issue int, id string, err error) {
+	if len(args) < 2 {
+		return 0, "", fmt.Errorf("specify an issue number and a comment id")
+	}
+	if issue, err = strconv.Atoi(args[0]); err != nil {
+		return 0, "", fmt.Errorf("%q is not a number", args[0])
+	}
+	return issue, args[1], nil
+}
+
+func newEditCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		issue int
+		id    string
+		text  string
+	)
+	c := &cobra.Command{
+		Use:   "edit {issueNum} {commentId} [text]",
+		Short: "Replace the text of a comment",
+		Long: `Replace the text of a comment.
+
+Comment ids are shown by 'comment list'.  If the text is "-" it's read
+from stdin.  If it's missing, your editor opens on the current text.`,
+		Example: `
+   comment edit 99 10234 "Waiting on the API review, due Friday."
+   comment edit 99 10234
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if issue, id, err = issueAndComment(args); err != nil {
+				return err
+			}
+			if len(args) > 3 {
+				return fmt.Errorf("put the comment text in quotes")
+			}
+			if len(args) == 3 {
+				text = args[2]
+			}
+			return nil
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			initial := ""
+			if text == "" {
+				old, err := jb.GetComment(issue, id)
+				if err != nil {
+					return err
+				}
+				initial = old.Body
+			}
+			body, err := utils.ReadText(text, initial)
+			if err != nil {
+				return err
+			}
+			return jb.EditComment(issue, id, body)
+		},
+	}
+	return c
+}
+
+func newDeleteCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		issue int
+		id    string
+	)
+	c := &cobra.Command{
+		Use:   "delete {issueNum} {commentId}",
+		Short: "Delete a comment",
+		Example: `
+   comment delete 99 10234
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) > 2 {
+				return fmt.Errorf("specify an issue number and one comment id")
+			}
+			issue, id, err = issueAndComment(args)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return jb.DeleteComment(issue, id)
+		},
+	}
+	return c
+}
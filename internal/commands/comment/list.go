@@ -0,0 +1,64 @@
+package comment
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(jb *myj.JiraBoss) *cobra.Command {
+	var issue int
+	c := &cobra.Command{
+		Use:   "list {issueNum}",
+		Short: "List the comments on an issue, oldest first",
+		Example: `
+   comment list 99
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			issue, err = oneIssue(args)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			comments, err := jb.GetComments(issue)
+			if err != nil {
+				return err
+			}
+			if len(comments) == 0 {
+				utils.DoErrF("%s has no comments\n", jb.Key(issue))
+				return nil
+			}
+			for i, c := range comments {
+				if i > 0 {
+					_, _ = fmt.Fprintln(os.Stdout)
+				}
+				edited := ""
+				if c.WasEdited() {
+					edited = " (edited)"
+				}
+				_, _ = fmt.Fprintf(os.Stdout, "[%s] %s, %s%s\n",
+					c.Id, c.AuthorName(), c.DateCreated(), edited)
+				for _, line := range strings.Split(strings.TrimSpace(c.Body), "\n") {
+					_, _ = fmt.Fprintf(os.Stdout, "  %s\n", strings.TrimRight(line, "\r"))
+				}
+			}
+			return nil
+		},
+	}
+	return c
+}
+
+func oneIssue(args []string) (int, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("specify one issue number")
+	}
+	n, err := utils.ConvertToInt(args)
+	if err != nil {
+		return 0, err
+	}
+	return n[0], nil
+}
@@ -14,9 +14,11 @@ func NewEpicCmd(jb *myj.JiraBoss) *cobra.Command {
 	c.AddCommand(
 		newFixNameCmd(jb),
 		newFixDatesCmd(jb),
+		newFitCmd(jb),
 		newGroupCmd(jb),
 		newUnGroupCmd(jb),
 		newExportCmd(jb),
+		newEditCmd(jb),
 		newCalCmd(jb),
 		newImportCmd(jb),
 		newDotCmd(jb),
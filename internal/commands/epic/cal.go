@@ -3,6 +3,7 @@ package epic
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/report"
@@ -10,15 +11,41 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var calFormats = []report.Format{
+	report.FormatText, report.FormatSvg, report.FormatHtml, report.FormatMermaid}
+
+var calGroupBys = []report.GroupBy{
+	report.GroupByNone, report.GroupByLabel,
+	report.GroupByAssignee, report.GroupByStatus}
+
 func newCalCmd(jb *myj.JiraBoss) *cobra.Command {
 	var (
 		calP        report.CalParams
 		flagPrevVal string
+		sprints     bool
+		stories     bool
+		board       int
+		format      string
+		calFormat   report.Format
+		groupBy     string
+		sortBy      string
+		filter      myj.EpicFilter
 	)
 	const (
 		flagPrevName    = "prev"
 		flagPrevDefault = "1m"
 		durationDefault = "5m"
+		flagSprints     = "sprints"
+		flagFormat      = "format"
+		flagGroupBy     = "group-by"
+		flagStories     = "stories"
+		flagSort        = "sort"
+		flagStatus      = "status"
+		flagLabel       = "label"
+		flagAssigned    = "assigned"
+		flagHideDone    = "hide-done"
+		flagJql         = "jql"
+		flagSlips       = "slips"
 	)
 	c := &cobra.Command{
 		Use:   "cal [duration]",
@@ -33,6 +60,42 @@ func newCalCmd(jb *myj.JiraBoss) *cobra.Command {
   To show more of the past, use --` + flagPrevName + `
 
     cal 6m --` + flagPrevName + ` 2m
+
+  To mark sprint boundaries in the date headers, use --` + flagSprints + `
+
+    cal 3m --` + flagSprints + `
+
+  To make a gantt chart for a slide or wiki page, use --` + flagFormat + `
+
+    cal 6m --` + flagFormat + ` svg >epics.svg
+    cal 6m --` + flagFormat + ` html >epics.html
+
+  To make a mermaid gantt chart for a README or PR description, with
+  a section per assignee, use
+
+    cal 6m --` + flagFormat + ` mermaid --` + flagGroupBy + ` assignee
+
+  To show each epic's stories under it, use --` + flagStories + `
+
+    cal 3m --` + flagStories + `
+
+  To show only the unfinished epics with a given label, in sections
+  by assignee, ordered by end date, use
+
+    cal 6m --` + flagLabel + ` infra --` + flagHideDone + ` --` + flagGroupBy + ` assignee --` + flagSort + ` end
+
+  Filters may be repeated, and arbitrary JQL may be added with --` + flagJql + `
+
+    cal 6m --` + flagStatus + ` "In Progress" --` + flagStatus + ` Backlog --` + flagJql + ` "priority = High"
+    cal 6m --` + flagAssigned + ` jdoe
+
+  To show how often each epic's end date has slipped, use --` + flagSlips + `
+
+    cal 6m --` + flagSlips + `
+
+  Stories that fall outside their epic's dates are flagged with a '!'.
+  The "stories span" row covers all of an epic's dated stories, so it
+  can be compared to the epic's own dates.
    
 `,
 		SilenceUsage: true,
@@ -57,19 +120,57 @@ func newCalCmd(jb *myj.JiraBoss) *cobra.Command {
 				return fmt.Errorf("invalid --%s %s: %w",
 					flagPrevName, flagPrevVal, err)
 			}
+			if calFormat, err = report.ParseFormat(format, calFormats...); err != nil {
+				return err
+			}
+			if calP.GroupBy, err = report.ParseGroupBy(groupBy, calGroupBys...); err != nil {
+				return err
+			}
+			if calP.SortBy, err = report.SortByString(sortBy); err != nil {
+				return fmt.Errorf("invalid --%s %s; use one of %s",
+					flagSort, sortBy, strings.Join(report.SortByStrings(), "|"))
+			}
 			start := utils.Today().SlideOverWeekend().AddDays(-prevDays)
 			calP.Outer, err = utils.MakeDayRangeSimple(start, dayCount+prevDays)
 			return err
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			orgEpicMap := jb.GetEpics()
+			if sprints {
+				var err error
+				if calP.Sprints, err = loadSprintRanges(jb, board); err != nil {
+					return err
+				}
+			}
+			orgEpicMap := jb.GetFilteredEpics(&filter)
 			epicMap := make(map[myj.MyKey]*myj.ResponseIssue)
 			for k, v := range orgEpicMap {
 				if k.Num < myj.UnknownEpicBase {
 					epicMap[k] = v
 				}
 			}
-			err := report.DoCal(os.Stdout, epicMap, calP)
+			if calP.ShowSlips {
+				if err := jb.LoadChangelogs(epicMap); err != nil {
+					return err
+				}
+			}
+			var issueMap map[myj.MyKey]myj.IssueList
+			if stories {
+				// This can add epics to orgEpicMap, but not to epicMap.
+				issueMap = jb.GetIssuesGroupedByEpic(orgEpicMap)
+			}
+			var err error
+			calP.ProjectName = jb.Project()
+			calP.DependencyLinkTypes = jb.DependencyLinkTypes()
+			switch calFormat {
+			case report.FormatSvg:
+				err = report.DoGanttSvg(os.Stdout, epicMap, issueMap, calP)
+			case report.FormatHtml:
+				err = report.DoGanttHtml(os.Stdout, epicMap, issueMap, calP)
+			case report.FormatMermaid:
+				err = report.DoMermaidGantt(os.Stdout, epicMap, issueMap, calP)
+			default:
+				err = report.DoCal(os.Stdout, epicMap, issueMap, calP)
+			}
 			if err != nil {
 				utils.DoErr1(err.Error())
 				utils.DoErr1("use '" + fixDatesCmd + "' command to see and repair errors")
@@ -84,5 +185,48 @@ func newCalCmd(jb *myj.JiraBoss) *cobra.Command {
 	c.Flags().IntVar(&calP.LineSetSize, "line-set-size", 3, "number of lines in a set")
 	c.Flags().StringVar(&flagPrevVal, flagPrevName, flagPrevDefault,
 		"number of previous days, weeks, months to show")
+	c.Flags().StringVar(&format, flagFormat, report.FormatText.String(),
+		"output format, one of "+report.FormatNames(calFormats...))
+	c.Flags().StringVar(&groupBy, flagGroupBy, report.GroupByNone.String(),
+		"put epics in sections by "+report.GroupByNames(calGroupBys...))
+	c.Flags().StringVar(&sortBy, flagSort, report.SortByStart.String(),
+		"order epics by "+strings.Join(report.SortByStrings(), "|"))
+	c.Flags().StringSliceVar(&filter.Statuses, flagStatus, nil,
+		"show only epics with this status")
+	c.Flags().StringSliceVar(&filter.Labels, flagLabel, nil,
+		"show only epics with this label")
+	c.Flags().StringSliceVar(&filter.Assignees, flagAssigned, nil,
+		"show only epics assigned to this user")
+	c.Flags().BoolVar(&filter.HideDone, flagHideDone, false,
+		"hide epics that are done or closed")
+	c.Flags().StringVar(&filter.Jql, flagJql, "",
+		"more JQL to AND with the epic query")
+	c.Flags().BoolVar(&sprints, flagSprints, false,
+		"mark sprint boundaries in the date headers")
+	c.Flags().BoolVar(&calP.ShowSlips, flagSlips, false,
+		"show a sparkline of each epic's end date slips")
+	c.Flags().BoolVar(&stories, flagStories, false,
+		"show each epic's stories under it")
+	c.Flags().IntVar(&board, "board", 0,
+		"id of the agile board holding the sprints (default is the project's first scrum board)")
 	return c
 }
+
+// loadSprintRanges returns the date ranges of the scheduled sprints on a board.
+func loadSprintRanges(jb *myj.JiraBoss, board int) ([]*utils.DayRange, error) {
+	id, err := jb.FindBoard(board)
+	if err != nil {
+		return nil, err
+	}
+	sprints, err := jb.GetSprints(id)
+	if err != nil {
+		return nil, err
+	}
+	var result []*utils.DayRange
+	for i := range sprints {
+		if dr, err := sprints[i].DayRange(); err == nil {
+			result = append(result, dr)
+		}
+	}
+	return result, nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
 	"github.com/spf13/cobra"
 )
 
@@ -12,11 +13,19 @@ const (
 	dotCmd = "dot"
 )
 
+var dotFormats = []report.Format{
+	report.FormatDot, report.FormatMermaid,
+	report.FormatPlantUml, report.FormatJson}
+
 func newDotCmd(jb *myj.JiraBoss) *cobra.Command {
-	var flagFlip bool
+	var (
+		flagFlip  bool
+		format    string
+		dotFormat report.Format
+	)
 	c := &cobra.Command{
 		Use:   dotCmd,
-		Short: "Emit dot program instructions to make a digraph of epic dependencies",
+		Short: "Emit dot (or other) program instructions to make a digraph of epic dependencies",
 		Example: `
   epic ` + dotCmd + ` >k.dot; dot -Tsvg k.dot >k.svg; display k.svg
 
@@ -25,12 +34,23 @@ or
   epic ` + dotCmd + ` | dot -Tsvg | display -
 
 Learn the language at https://graphviz.org/doc/info/lang.html
+
+To embed the graph in markdown (GitHub, GitLab) or Confluence,
+which render mermaid and plantuml without a local install:
+
+  epic ` + dotCmd + ` --format mermaid
+  epic ` + dotCmd + ` --format plantuml
+
+To feed the graph to some other tool:
+
+  epic ` + dotCmd + ` --format json
 `,
-		Args: func(_ *cobra.Command, args []string) error {
+		Args: func(_ *cobra.Command, args []string) (err error) {
 			if len(args) > 0 {
 				return fmt.Errorf("this command takes no arguments")
 			}
-			return nil
+			dotFormat, err = report.ParseFormat(format, dotFormats...)
+			return err
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) error {
@@ -38,7 +58,18 @@ Learn the language at https://graphviz.org/doc/info/lang.html
 			if err != nil {
 				return err
 			}
-			g.WriteDigraph(os.Stdout, flagFlip)
+			switch dotFormat {
+			case report.FormatMermaid:
+				g.WriteMermaid(os.Stdout, flagFlip)
+			case report.FormatPlantUml:
+				g.WritePlantUml(os.Stdout, flagFlip)
+			case report.FormatJson:
+				if err = g.WriteJson(os.Stdout); err != nil {
+					return err
+				}
+			default:
+				g.WriteDigraph(os.Stdout, flagFlip)
+			}
 			g.ReportMisOrdering(os.Stderr)
 			g.ReportWeekends(os.Stderr)
 			return nil
@@ -46,5 +77,7 @@ Learn the language at https://graphviz.org/doc/info/lang.html
 	}
 	c.Flags().BoolVar(&flagFlip, "flip", false,
 		"flip the diagram (put end goal at top)")
+	c.Flags().StringVar(&format, "format", report.FormatDot.String(),
+		"output format, one of "+report.FormatNames(dotFormats...))
 	return c
 }
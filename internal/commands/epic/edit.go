@@ -0,0 +1,241 @@
+package epic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/troper"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	editHelp = "Edit epics, and maybe their stories, in your editor"
+	editCmd  = "edit"
+
+	// errorPrefix marks the lines added to point out problems;
+	// they're removed before the file is parsed again.
+	errorPrefix = "# error: "
+	editHeader  = `# Edit the epics below, then save and quit.
+# Indented lines are issues in the epic above them;
+# move them to move an issue to another epic.
+# Lines starting with '#' are ignored.  Empty the file to abort.
+`
+)
+
+func newEditCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		epics      []int
+		storiesToo bool
+	)
+	c := &cobra.Command{
+		Use:   editCmd + " [{epicNum}...]",
+		Short: editHelp,
+		Long: editHelp + `.
+
+Writes what '` + exportCmd + `' would print to a temporary file, and opens
+it in $VISUAL or $EDITOR.  After you save and quit, the changes are
+checked as '` + importCmd + `' would check them, shown, and written
+after you confirm.  Only changed lines are written.
+
+If the file can't be parsed, or the checks fail, the editor is opened
+again with the problems noted above the offending lines.
+`,
+		Example:      `  gojira epic ` + editCmd + ` 1234 1240 --stories`,
+		SilenceUsage: true,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			epics, err = utils.ConvertToInt(args)
+			return err
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			em, im, err := gatherEpics(jb, epics, storiesToo, 0)
+			if err != nil {
+				return err
+			}
+			var b strings.Builder
+			report.SpewEpics(&b, em, im, jb.DetermineEpicLink)
+			return editEpics(jb, b.String())
+		},
+	}
+	c.Flags().BoolVar(&storiesToo, "stories", false, "edit stories in epic too")
+	return c
+}
+
+// editEpics loops until the user's edit of the original text
+// parses and passes the checks, or the user gives up.
+func editEpics(jb *myj.JiraBoss, original string) error {
+	before, err := parseEdit(original)
+	if err != nil {
+		return fmt.Errorf("cannot parse the exported epics; %w", err)
+	}
+	text := editHeader + original
+	for {
+		text, err = utils.EditText(text, ".txt")
+		if err != nil {
+			return err
+		}
+		text = removeAnnotations(text)
+		if len(contentLines(text)) == 0 {
+			fmt.Println("Empty file; nothing written.")
+			return nil
+		}
+		after, err := parseEdit(text)
+		if err != nil {
+			var le *troper.LineError
+			if !errors.As(err, &le) {
+				return err
+			}
+			text = annotate(text, map[int][]string{le.Line: {le.Err.Error()}})
+			continue
+		}
+		em, im := after.changedSince(before)
+		if len(em) == 0 && len(im) == 0 {
+			fmt.Println("No changes.")
+			return nil
+		}
+		if err = jb.CheckEpics(em); err == nil {
+			err = jb.CheckIssues(im)
+		}
+		if err != nil {
+			var ce *myj.CheckError
+			if !errors.As(err, &ce) {
+				return err
+			}
+			notes := make(map[int][]string)
+			for k, msgs := range ce.Problems {
+				// Zero, i.e. the top of the file, if the key isn't in it.
+				n := after.lineNum[k]
+				notes[n] = append(notes[n], msgs...)
+			}
+			text = annotate(text, notes)
+			continue
+		}
+		for _, line := range utils.DiffLines(
+			contentLines(original), contentLines(text)) {
+			fmt.Println(line)
+		}
+		switch utils.Ask("Write these changes? [y/N/e(dit again)] ") {
+		case "y", "yes":
+			if err = jb.WriteEpics(em); err != nil {
+				return err
+			}
+			return jb.WriteIssues(im)
+		case "e", "edit":
+			continue
+		default:
+			fmt.Println("Nothing written.")
+			return nil
+		}
+	}
+}
+
+// editedEpics is the result of parsing an edit.
+type editedEpics struct {
+	em map[myj.MyKey]*myj.ResponseIssue
+	im map[myj.MyKey]myj.IssueList
+	// line holds each issue's line, with spacing normalized.
+	line map[myj.MyKey]string
+	// lineNum holds each issue's (one-based) line number.
+	lineNum map[myj.MyKey]int
+}
+
+func parseEdit(text string) (*editedEpics, error) {
+	parsed, nums, err := troper.ParseEpics([]byte(text))
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(text, "\n")
+	result := &editedEpics{
+		line:    make(map[myj.MyKey]string),
+		lineNum: make(map[myj.MyKey]int),
+	}
+	for i, p := range parsed {
+		k := myj.MyKey{Proj: p.Proj, Num: p.Num}
+		if _, dup := result.lineNum[k]; dup {
+			return nil, &troper.LineError{
+				Line: nums[i], Err: fmt.Errorf("%s appears more than once", k)}
+		}
+		result.lineNum[k] = nums[i]
+		result.line[k] = strings.Join(strings.Fields(lines[nums[i]-1]), " ")
+	}
+	result.em, result.im = troper.Convert(parsed)
+	return result, nil
+}
+
+// changedSince returns the epics and issues whose lines differ from
+// those in before, or which moved to another epic.
+func (ee *editedEpics) changedSince(before *editedEpics) (
+	map[myj.MyKey]*myj.ResponseIssue, map[myj.MyKey]myj.IssueList) {
+	em := make(map[myj.MyKey]*myj.ResponseIssue)
+	for k, epic := range ee.em {
+		if ee.line[k] != before.line[k] {
+			em[k] = epic
+		}
+	}
+	oldEpic := make(map[myj.MyKey]myj.MyKey)
+	for epicKey, list := range before.im {
+		for _, issue := range list {
+			oldEpic[issue.MyKey] = epicKey
+		}
+	}
+	im := make(map[myj.MyKey]myj.IssueList)
+	for epicKey, list := range ee.im {
+		for _, issue := range list {
+			k := issue.MyKey
+			if ee.line[k] != before.line[k] || oldEpic[k] != epicKey {
+				im[epicKey] = append(im[epicKey], issue)
+			}
+		}
+	}
+	return em, im
+}
+
+// annotate inserts the notes above the lines they refer to;
+// notes for line zero go at the top.
+func annotate(text string, notes map[int][]string) string {
+	lines := strings.Split(text, "\n")
+	var b strings.Builder
+	write := func(n int) {
+		msgs := notes[n]
+		sort.Strings(msgs)
+		for _, msg := range msgs {
+			b.WriteString(errorPrefix + msg + "\n")
+		}
+	}
+	write(0)
+	for i, line := range lines {
+		write(i + 1)
+		b.WriteString(line)
+		if i < len(lines)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// removeAnnotations removes the notes added by annotate.
+func removeAnnotations(text string) string {
+	var kept []string
+	for _, line := range strings.Split(text, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), strings.TrimSpace(errorPrefix)) {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+// contentLines returns the lines that aren't blank or comments.
+func contentLines(text string) []string {
+	var result []string
+	for _, line := range strings.Split(text, "\n") {
+		t := strings.TrimSpace(line)
+		if t != "" && !strings.HasPrefix(t, "#") {
+			result = append(result, strings.TrimRight(line, " \t"))
+		}
+	}
+	return result
+}
@@ -13,12 +13,14 @@ import (
 const (
 	exportHelp = "Print all epics, optionally with their stories"
 	exportCmd  = "export"
+	flagSprint = "sprint"
 )
 
 func newExportCmd(jb *myj.JiraBoss) *cobra.Command {
 	var (
 		epics      []int
 		storiesToo bool
+		sprint     int
 	)
 	c := &cobra.Command{
 		Use:   exportCmd + " [{epicNum}...]",
@@ -31,6 +33,9 @@ starting at project-9000.
 
 The output of '` + exportCmd + `' can be read by '` + importCmd + `' to perform
 bulk title edits, or bulk re-arrangement of which stories go into which epics.
+
+With --` + flagSprint + `, only stories in the given sprint (and the epics
+holding them) are printed.
 `,
 		SilenceUsage: true,
 		Args: func(_ *cobra.Command, args []string) (err error) {
@@ -38,25 +43,9 @@ bulk title edits, or bulk re-arrangement of which stories go into which epics.
 			return err
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var epicMap map[myj.MyKey]*myj.ResponseIssue
-			var issueMap map[myj.MyKey]myj.IssueList
-			if len(epics) > 0 {
-				epicMap = make(map[myj.MyKey]*myj.ResponseIssue)
-				for i := range epics {
-					issue, err := jb.GetOneIssue(epics[i])
-					if err != nil {
-						return err
-					}
-					if issue.Type() != myj.IssueTypeEpic {
-						return fmt.Errorf("%d is not an epic", epics[i])
-					}
-					epicMap[issue.MakeMyKey()] = issue
-				}
-			} else {
-				epicMap = jb.GetEpics()
-			}
-			if storiesToo {
-				issueMap = jb.GetIssuesGroupedByEpic(epicMap)
+			epicMap, issueMap, err := gatherEpics(jb, epics, storiesToo, sprint)
+			if err != nil {
+				return err
 			}
 			report.SpewEpics(
 				os.Stdout, epicMap, issueMap, jb.DetermineEpicLink)
@@ -64,5 +53,46 @@ bulk title edits, or bulk re-arrangement of which stories go into which epics.
 		},
 	}
 	c.Flags().BoolVar(&storiesToo, "stories", false, "show stories in epic")
+	c.Flags().IntVar(&sprint, flagSprint, 0,
+		"show only stories in the given sprint (implies --stories)")
 	return c
 }
+
+// gatherEpics gets the given epics, or all of them if none are given,
+// and maybe their stories, limited to those in the sprint if it's
+// not zero.
+func gatherEpics(jb *myj.JiraBoss, epics []int, storiesToo bool, sprint int) (
+	epicMap map[myj.MyKey]*myj.ResponseIssue,
+	issueMap map[myj.MyKey]myj.IssueList,
+	err error) {
+	if len(epics) > 0 {
+		epicMap = make(map[myj.MyKey]*myj.ResponseIssue)
+		for i := range epics {
+			issue, err := jb.GetOneIssue(epics[i])
+			if err != nil {
+				return nil, nil, err
+			}
+			if !jb.IsEpic(issue) {
+				return nil, nil, fmt.Errorf("%d is not an epic", epics[i])
+			}
+			epicMap[issue.MakeMyKey()] = issue
+		}
+	} else {
+		epicMap = jb.GetEpics()
+	}
+	if storiesToo || sprint > 0 {
+		issueMap = jb.GetIssuesGroupedByEpic(epicMap)
+	}
+	if sprint > 0 {
+		issueMap, err = jb.KeepSprintIssues(sprint, issueMap)
+		if err != nil {
+			return nil, nil, err
+		}
+		for k := range epicMap {
+			if _, ok := issueMap[k]; !ok {
+				delete(epicMap, k)
+			}
+		}
+	}
+	return epicMap, issueMap, nil
+}
@@ -0,0 +1,81 @@
+package epic
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newFitCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		epics              []int
+		all, shrink, clamp bool
+		doIt               bool
+	)
+	const (
+		flagAll    = "all"
+		flagShrink = "shrink"
+		flagClamp  = "clamp"
+	)
+	c := &cobra.Command{
+		Use:   "fit {epicNum}...",
+		Short: "Fit epic dates to cover the dates of their stories",
+		Long: `Fit epic dates to cover the dates of their stories.
+
+By default an epic only grows, starting no later than its earliest
+story and ending no earlier than its latest.  With --` + flagShrink + ` it
+exactly matches the span of its stories.  With --` + flagClamp + ` the
+epic is left alone, and story dates are moved into the epic's dates.
+
+Only open stories with both dates are considered.  Changes are
+reported, and only written with --` + myj.FlagDoIt + `.`,
+		Example: `
+   epic fit 120
+   epic fit 120 121 --` + flagShrink + ` --` + myj.FlagDoIt + `
+   epic fit --` + flagAll + ` --` + flagClamp + `
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if all == (len(args) > 0) {
+				return fmt.Errorf("specify some epic numbers or --%s", flagAll)
+			}
+			if shrink && clamp {
+				return fmt.Errorf("--%s and --%s don't mix", flagShrink, flagClamp)
+			}
+			epics, err = utils.ConvertToInt(args)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			em := jb.GetEpics()
+			if !all {
+				chosen := make(map[myj.MyKey]*myj.ResponseIssue)
+				for _, n := range epics {
+					k := jb.Key(n)
+					epic, ok := em[k]
+					if !ok {
+						return fmt.Errorf("%s is not an epic in this project", k)
+					}
+					chosen[k] = epic
+				}
+				em = chosen
+			}
+			// Grouping adds epics outside the project to the map.
+			stories := jb.GetIssuesGroupedByEpic(maps.Clone(em))
+			if clamp {
+				return jb.WriteDates(doIt, myj.ClampStories(em, stories))
+			}
+			return jb.WriteDates(doIt, myj.FitEpics(em, stories, shrink))
+		},
+	}
+	c.Flags().BoolVar(&all, flagAll, false, "fit all epics in the project")
+	c.Flags().BoolVar(&shrink, flagShrink, false,
+		"also shrink epics to the span of their stories")
+	c.Flags().BoolVar(&clamp, flagClamp, false,
+		"move story dates into their epic's dates instead")
+	c.Flags().BoolVar(&doIt, myj.FlagDoIt, false,
+		"actually write new dates, rather than just report")
+	return c
+}
@@ -3,6 +3,7 @@ package epic
 import (
 	"fmt"
 
+	"github.com/monopole/gojira/internal/commands/selection"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ func newGroupCmd(jb *myj.JiraBoss) *cobra.Command {
 	var (
 		issues []int
 		epic   int
+		sel    = selection.New(false)
 	)
 	c := &cobra.Command{
 		Use: "group",
@@ -21,9 +23,11 @@ func newGroupCmd(jb *myj.JiraBoss) *cobra.Command {
 To specify epic 33 as the epic for the issues 111 and 118 enter:
 
     epic group 33 111 118
+
+` + selection.Usage + `
 `,
 		Args: func(_ *cobra.Command, args []string) (err error) {
-			if len(args) < 2 {
+			if len(args) < 2 && !(len(args) == 1 && sel.IsSet()) {
 				return fmt.Errorf(
 					"specify an epic number and at least one issue number")
 			}
@@ -37,6 +41,9 @@ To specify epic 33 as the epic for the issues 111 and 118 enter:
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) (err error) {
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
 			for i := range issues {
 				if err = jb.SetEpicLink(issues[i], epic); err != nil {
 					return err
@@ -45,11 +52,15 @@ To specify epic 33 as the epic for the issues 111 and 118 enter:
 			return nil
 		},
 	}
+	sel.AddFlags(c.Flags())
 	return c
 }
 
 func newUnGroupCmd(jb *myj.JiraBoss) *cobra.Command {
-	var issues []int
+	var (
+		issues []int
+		sel    = selection.New(false)
+	)
 	c := &cobra.Command{
 		Use: "ungroup",
 		Short: `Clear the '` + myj.CustomFieldEpicLink +
@@ -60,13 +71,18 @@ This undoes the work of the 'group' command.
 To clear the ` + myj.CustomFieldEpicLink + ` field for issues 111 and 118 enter:
 
     epic ungroup 111 118
+
+` + selection.Usage + `
 `,
 		Args: func(_ *cobra.Command, args []string) (err error) {
 			issues, err = utils.ConvertToInt(args)
 			return err
 		},
 		SilenceUsage: true,
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(_ *cobra.Command, _ []string) (err error) {
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
 			for i := range issues {
 				if err := jb.ClearEpicLink(issues[i]); err != nil {
 					return err
@@ -75,5 +91,6 @@ To clear the ` + myj.CustomFieldEpicLink + ` field for issues 111 and 118 enter:
 			return nil
 		},
 	}
+	sel.AddFlags(c.Flags())
 	return c
 }
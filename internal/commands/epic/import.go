@@ -65,7 +65,7 @@ the additional flag --` + flagDoIt + `.
 				return err
 			}
 			fmt.Println("Issues look good.")
-			if !doIt {
+			if !doIt && !jb.DryRun() {
 				return fmt.Errorf("add --" + flagDoIt + " to actually perform the write")
 			}
 			if err = jb.WriteEpics(em); err != nil {
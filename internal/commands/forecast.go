@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newForecastCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		epic       int
+		weeks      int
+		trials     int
+		downstream bool
+	)
+	const flagDownstream = "downstream"
+	c := &cobra.Command{
+		Use:   "forecast {epicNum}",
+		Short: "Forecast when an epic will be done",
+		Long: `Forecast when an epic will be done.
+
+Runs a Monte Carlo simulation of finishing the epic's open stories,
+drawing each week's throughput at random from the project's recent
+weekly throughput, and reports the dates by which 50, 85 and 95
+percent of the trials finished.`,
+		Example: `
+   forecast 120
+   forecast 120 --weeks 26
+
+To see which epics blocked by this one would be pushed later
+(this looks at every epic in the project, so it's slow):
+
+   forecast 120 --` + flagDownstream + `
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return fmt.Errorf("specify one epic")
+			}
+			if epic, err = strconv.Atoi(args[0]); err != nil {
+				return fmt.Errorf("%q is not a number", args[0])
+			}
+			if weeks < 1 || trials < 1 {
+				return fmt.Errorf("weeks and trials must be positive")
+			}
+			return nil
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			issue, err := jb.GetOneIssue(epic)
+			if err != nil {
+				return err
+			}
+			if !jb.IsEpic(issue) {
+				return fmt.Errorf("%s is not an epic", issue.MyKey)
+			}
+			stories, err := jb.GetAllIssuesInEpic(epic)
+			if err != nil {
+				return err
+			}
+			remaining := 0
+			for _, s := range stories {
+				if !s.SeemsDone() {
+					remaining++
+				}
+			}
+			throughput, err := jb.GetWeeklyThroughput(weeks)
+			if err != nil {
+				return err
+			}
+			f, err := report.MakeForecast(
+				throughput, remaining, trials,
+				rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())))
+			if err != nil {
+				return err
+			}
+			var shifts map[int][]myj.Shift
+			if downstream {
+				if shifts, err = forecastDownstream(jb, issue, f); err != nil {
+					return err
+				}
+			}
+			report.DoForecast(os.Stdout, issue, f, shifts)
+			return nil
+		},
+	}
+	c.Flags().IntVar(&weeks, "weeks", 12,
+		"number of past weeks of throughput to draw from")
+	c.Flags().IntVar(&trials, "trials", 10000,
+		"number of simulations to run")
+	c.Flags().BoolVar(&downstream, flagDownstream, false,
+		"show the effect of the forecast on epics blocked by this one")
+	return c
+}
+
+// forecastDownstream returns, for each forecast percentile,
+// the epics that would be pushed later if the epic ended then.
+func forecastDownstream(
+	jb *myj.JiraBoss, epic *myj.ResponseIssue,
+	f *report.Forecast) (map[int][]myj.Shift, error) {
+	g, err := jb.CreateDiGraph()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int][]myj.Shift)
+	for _, p := range report.ForecastPercentiles {
+		if result[p], err = g.ProjectEnd(
+			epic.MyKey, f.Date(p, utils.Today())); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
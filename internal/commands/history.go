@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newHistoryCmd(jb *myj.JiraBoss) *cobra.Command {
+	var issues []int
+	c := &cobra.Command{
+		Use:   "history {issueNum}...",
+		Short: "Show the history of changes to the dates of the given issues",
+		Example: `
+To see when and by how much the dates of epic 120 changed, and who changed them:
+
+   history 120
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			issues, err = utils.ConvertToInt(args)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			for _, n := range issues {
+				issue, err := jb.GetOneIssueWithChangelog(n)
+				if err != nil {
+					return err
+				}
+				report.DoHistory(os.Stdout, issue)
+			}
+			return nil
+		},
+	}
+	return c
+}
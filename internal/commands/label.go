@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+
+	"github.com/monopole/gojira/internal/commands/selection"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
 	"github.com/spf13/cobra"
@@ -12,6 +14,7 @@ func newLabelCmd(jb *myj.JiraBoss) *cobra.Command {
 		label  string
 		issues []int
 		remove bool
+		sel    = selection.New(false)
 	)
 	c := &cobra.Command{
 		Use:   "label {label} {issueNum}...",
@@ -24,9 +27,15 @@ To add the label 'critical' to issues 100, 200 and 300:
 To remove it:
 
    label --remove critical 100 200 300
+
+To add it to all open stories labeled 'stretch':
+
+   label critical --jql "labels = stretch AND statusCategory != Done"
+
+` + selection.Usage + `
 `,
 		Args: func(_ *cobra.Command, args []string) (err error) {
-			if len(args) < 2 {
+			if len(args) < 2 && !(len(args) == 1 && sel.IsSet()) {
 				return fmt.Errorf("specify at least a label and one issue")
 			}
 			label = args[0]
@@ -35,10 +44,14 @@ To remove it:
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) (err error) {
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
 			return jb.LabelIssues(label, issues, remove)
 		},
 	}
 	c.Flags().BoolVarP(
 		&remove, "remove", "r", false, "remove the label instead of add the label")
+	sel.AddFlags(c.Flags())
 	return c
 }
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newLinkCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		issues   []int
+		linkType string
+		comment  string
+		remove   bool
+		list     bool
+	)
+	const (
+		flagType = "type"
+		flagList = "list"
+	)
+	c := &cobra.Command{
+		Use:   "link {issue} {other} {another}...",
+		Short: "Link (or unlink) an issue to other issues",
+		Long: `Link (or unlink) an issue to other issues.
+
+The first issue is on the inward side of the link, e.g. with the
+"Blocks" type, the first issue blocks the others.  Link types are
+discovered from jira; see them with --` + flagList + `.`,
+		Example: `
+  To indicate that issue 99 relates to issues 200 and 201:
+
+    link --` + flagType + ` Relates 99 200 201
+
+  To undo that:
+
+    link --` + flagType + ` Relates --remove 99 200 201
+
+  To see the link types:
+
+    link --` + flagList + `
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if list {
+				return nil
+			}
+			if len(args) < 2 {
+				return fmt.Errorf("specify at least two issues")
+			}
+			issues, err = utils.ConvertToInt(args)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if list {
+				types, err := jb.GetLinkTypes()
+				if err != nil {
+					return err
+				}
+				for _, t := range types {
+					_, _ = fmt.Fprintf(os.Stdout, "%-16s %q / %q\n", t.Name, t.Outward, t.Inward)
+				}
+				return nil
+			}
+			t, err := jb.FindLinkType(linkType)
+			if err != nil {
+				return err
+			}
+			if remove {
+				return jb.UnLinkIssues(t, issues[0], issues[1:])
+			}
+			return jb.LinkIssues(t, issues[0], issues[1:], comment)
+		},
+	}
+	c.Flags().StringVar(&linkType, flagType, myj.LinkTypeBlocks, "the link type")
+	c.Flags().StringVar(&comment, "comment", "", "comment on the link")
+	c.Flags().BoolVar(&remove, "remove", false, "remove the links instead of adding them")
+	c.Flags().BoolVar(&list, flagList, false, "list the link types")
+	return c
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"fmt"
+	"maps"
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/spf13/cobra"
+)
+
+var lintFormats = []report.Format{report.FormatText, report.FormatJson}
+
+func newLintCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		fix    bool
+		format string
+		f      report.Format
+	)
+	const (
+		flagFix    = "fix"
+		flagFormat = "format"
+	)
+	c := &cobra.Command{
+		Use:   "lint",
+		Short: "Check the project's epics and open stories for data problems",
+		Long: `Check the project's epics and open stories for data problems.
+
+Each finding names a rule and has a severity.  The rules are
+
+  epic-name            (warning) epic name differs from its summary
+  missing-dates        (error)   unfinished epic lacks a start or end date
+  end-before-start     (error)   issue ends before it starts
+  weekend-date         (info)    issue starts or ends on a weekend
+  outside-epic         (warning) story dates fall outside its epic's dates
+  orphan-story         (warning) story has no epic
+  done-epic-open-work  (error)   epic is done but has open stories
+
+The command fails if any error is found, so it can be used in CI.
+With --` + flagFix + `, epic names are copied from summaries, and weekend
+dates slide onto weekdays, before reporting what's left.`,
+		Example: `
+   lint
+   lint --` + flagFormat + ` json
+   lint --` + flagFix + `
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) > 0 {
+				return fmt.Errorf("this command takes no arguments")
+			}
+			f, err = report.ParseFormat(format, lintFormats...)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			epics := jb.GetEpicsWithPlaceholder()
+			// Grouping adds the epics of stories outside the project
+			// to the map; don't lint those.
+			ours := maps.Clone(epics)
+			stories := jb.GetIssuesGroupedByEpic(epics)
+			findings := myj.Lint(ours, stories)
+			if fix {
+				if err := jb.FixLint(findings, ours, stories); err != nil {
+					return err
+				}
+				var left []myj.LintFinding
+				for _, x := range findings {
+					if !x.Fixable {
+						left = append(left, x)
+					}
+				}
+				findings = left
+			}
+			if err := report.DoLint(os.Stdout, findings, f); err != nil {
+				return err
+			}
+			errCount := 0
+			for _, x := range findings {
+				if x.Severity == myj.LintSeverityError {
+					errCount++
+				}
+			}
+			if errCount > 0 {
+				return fmt.Errorf("lint found %d errors", errCount)
+			}
+			return nil
+		},
+	}
+	c.Flags().BoolVar(&fix, flagFix, false, "apply the safe fixes")
+	c.Flags().StringVar(&format, flagFormat, report.FormatText.String(),
+		"output format, one of "+report.FormatNames(lintFormats...))
+	return c
+}
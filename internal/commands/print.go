@@ -3,22 +3,32 @@ package commands
 import (
 	"os"
 
+	"github.com/monopole/gojira/internal/commands/selection"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 func newPrintCmd(jb *myj.JiraBoss) *cobra.Command {
-	var issues []int
+	var (
+		issues []int
+		sel    = selection.New(true)
+	)
 	c := &cobra.Command{
 		Use:   "print",
 		Short: "Print information about the given issues",
+		Long: `Print information about the given issues.
+
+` + selection.Usage,
 		Args: func(_ *cobra.Command, args []string) (err error) {
 			issues, err = utils.ConvertToInt(args)
 			return err
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) (err error) {
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
 			var issue *myj.ResponseIssue
 			for i := range issues {
 				issue, err = jb.GetOneIssue(issues[i])
@@ -30,5 +40,6 @@ func newPrintCmd(jb *myj.JiraBoss) *cobra.Command {
 			return nil
 		},
 	}
+	sel.AddFlags(c.Flags())
 	return c
 }
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var queryFormats = []report.Format{
+	report.FormatText, report.FormatJson, report.FormatMarkdown}
+
+func newQueryCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		filter   myj.IssueFilter
+		since    string
+		format   string
+		printJql bool
+		f        report.Format
+	)
+	const (
+		flagType     = "type"
+		flagStatus   = "status"
+		flagLabel    = "label"
+		flagAssignee = "assignee"
+		flagEpic     = "epic"
+		flagSince    = "updated-since"
+		flagText     = "text"
+		flagJql      = "jql"
+		flagOrderBy  = "order-by"
+		flagFormat   = "format"
+		flagPrintJql = "print-jql"
+	)
+	c := &cobra.Command{
+		Use:   "query",
+		Short: "List the project's issues that match the given flags",
+		Long: `List the project's issues that match the given flags.
+
+Flags taking lists match any value in the list; different flags
+must all match.  The JQL made from the flags can be printed, to
+paste into jira or to refine with --` + flagJql + `.
+`,
+		Example: `
+Your open stories and tasks:
+
+   query --` + flagAssignee + ` me --` + flagType + ` Story,Task --open
+
+Unassigned issues in epic 1234 updated in the last two weeks:
+
+   query --` + flagEpic + ` 1234 --` + flagAssignee + ` none --` + flagSince + ` 2w
+
+Issues mentioning "flaky", newest first, as json:
+
+   query --` + flagText + ` flaky --` + flagOrderBy + ` -created --` + flagFormat + ` json
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) > 0 {
+				return fmt.Errorf("query takes no arguments; use flags")
+			}
+			if since != "" {
+				d, err := parseSince(since)
+				if err != nil {
+					return fmt.Errorf("invalid --%s %q; %w", flagSince, since, err)
+				}
+				filter.UpdatedSince = &d
+			}
+			f, err = report.ParseFormat(format, queryFormats...)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if printJql {
+				fmt.Println(jb.JqlQuery(&filter))
+				return nil
+			}
+			issues, err := jb.QueryIssues(&filter)
+			if err != nil {
+				return err
+			}
+			return report.DoIssues(os.Stdout, issues, f, jb.DetermineEpicLink)
+		},
+	}
+	c.Flags().StringSliceVar(&filter.Types, flagType, nil,
+		"issue types, e.g. Story,Task")
+	c.Flags().StringSliceVar(&filter.Statuses, flagStatus, nil,
+		"statuses, e.g. \"In Progress\",Blocked")
+	c.Flags().StringSliceVar(&filter.Labels, flagLabel, nil, "labels")
+	c.Flags().StringSliceVar(&filter.Assignees, flagAssignee, nil,
+		"assignees; '"+myj.AssigneeMe+"' is you, '"+myj.AssigneeNone+"' is nobody")
+	c.Flags().IntVar(&filter.Epic, flagEpic, 0, "number of the issues' epic")
+	c.Flags().StringVar(&since, flagSince, "",
+		"a date like 2026-Sep-01, or a time ago like 3d or 2w")
+	c.Flags().StringVar(&filter.Text, flagText, "",
+		"text to search for in summaries, descriptions and comments")
+	c.Flags().BoolVar(&filter.HideDone, "open", false, "omit issues that are done")
+	c.Flags().StringVar(&filter.Jql, flagJql, "", "more JQL, ANDed with the flags")
+	c.Flags().StringSliceVar(&filter.OrderBy, flagOrderBy, []string{"key"},
+		"fields to order by; prefix with '-' for descending order")
+	c.Flags().StringVar(&format, flagFormat, report.FormatText.String(),
+		"output format, one of "+report.FormatNames(queryFormats...))
+	c.Flags().BoolVar(&printJql, flagPrintJql, false,
+		"print the JQL rather than running it")
+	return c
+}
+
+// parseSince accepts a date, or a day count taken to be that many days ago.
+func parseSince(s string) (utils.Date, error) {
+	if d, err := utils.ParseDate(s); err == nil {
+		return d, nil
+	}
+	n, err := utils.ConvertToDayCount(s)
+	if err != nil {
+		return utils.Date{}, err
+	}
+	return utils.Today().AddDays(-n), nil
+}
@@ -0,0 +1,22 @@
+package reports
+
+import (
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/spf13/cobra"
+)
+
+func NewReportCmd(jb *myj.JiraBoss) *cobra.Command {
+	c := &cobra.Command{
+		Use:          "report",
+		Short:        "Write reports about the project",
+		SilenceUsage: true,
+	}
+	c.AddCommand(
+		newWeeklyCmd(jb),
+		newSlipsCmd(jb),
+		newBurndownCmd(jb),
+		newFlowCmd(jb),
+		newLoadCmd(jb),
+	)
+	return c
+}
@@ -0,0 +1,66 @@
+package reports
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/spf13/cobra"
+)
+
+var burndownFormats = []report.Format{report.FormatText, report.FormatSvg}
+
+func newBurndownCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		epic   int
+		format string
+		f      report.Format
+	)
+	const flagFormat = "format"
+	c := &cobra.Command{
+		Use:   "burndown {epicNum}",
+		Short: "Chart remaining and completed stories in an epic",
+		Long: `Chart remaining and completed stories in an epic.
+
+The chart spans the epic's dates, and has an ideal line falling
+from all stories to none over that span.  The projected completion
+date assumes the throughput of the last two weeks continues.`,
+		Example: `
+   report burndown 120
+   report burndown 120 --` + flagFormat + ` svg >burndown.svg
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return fmt.Errorf("specify one epic")
+			}
+			if epic, err = strconv.Atoi(args[0]); err != nil {
+				return fmt.Errorf("%q is not a number", args[0])
+			}
+			f, err = report.ParseFormat(format, burndownFormats...)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			issue, err := jb.GetOneIssue(epic)
+			if err != nil {
+				return err
+			}
+			if !jb.IsEpic(issue) {
+				return fmt.Errorf("%s is not an epic", issue.MyKey)
+			}
+			stories, err := jb.GetAllIssuesInEpic(epic)
+			if err != nil {
+				return err
+			}
+			if f == report.FormatSvg {
+				return report.DoBurndownSvg(os.Stdout, issue, stories)
+			}
+			return report.DoBurndown(os.Stdout, issue, stories)
+		},
+	}
+	c.Flags().StringVar(&format, flagFormat, report.FormatText.String(),
+		"output format, one of "+report.FormatNames(burndownFormats...))
+	return c
+}
@@ -0,0 +1,82 @@
+package reports
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var flowGroupBys = []report.GroupBy{
+	report.GroupByNone, report.GroupByEpic,
+	report.GroupByType, report.GroupByAssignee}
+
+func newFlowCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		rangeArg string
+		groupBy  string
+		dr       *utils.DayRange
+		by       report.GroupBy
+	)
+	const (
+		flagRange   = "range"
+		flagGroupBy = "group-by"
+	)
+	c := &cobra.Command{
+		Use:   "flow",
+		Short: "Show lead and cycle times of issues resolved in some range",
+		Long: `Show lead and cycle times of issues resolved in some range.
+
+Lead time runs from an issue's creation to done, and cycle time runs
+from when it first went in progress to done.  Both are computed from
+the status changes in the issue's history, in whole days.`,
+		Example: `
+   report flow
+   report flow --` + flagRange + ` 2026-Jul-01:2026-Sep-30 --` + flagGroupBy + ` epic
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) > 0 {
+				return fmt.Errorf("unexpected arguments %v", args)
+			}
+			if rangeArg == "" {
+				dr, err = utils.MakeDayRangeSimple(utils.Today().AddDays(-29), 30)
+			} else {
+				dr, err = utils.MakeRangeFromStringPair(rangeArg)
+			}
+			if err != nil {
+				return fmt.Errorf("invalid --%s %q; %w", flagRange, rangeArg, err)
+			}
+			by, err = report.ParseGroupBy(groupBy, flowGroupBys...)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			issues, err := jb.GetIssuesResolved(dr)
+			if err != nil {
+				return err
+			}
+			if len(issues) == 0 {
+				utils.DoErrF("no issues resolved in %s", dr.PrettyRange())
+				return nil
+			}
+			catalog, err := jb.GetCatalog()
+			if err != nil {
+				return err
+			}
+			flows := make([]myj.Flow, len(issues))
+			for i := range issues {
+				flows[i] = issues[i].Flow(catalog)
+			}
+			report.DoFlow(os.Stdout, flows, by)
+			return nil
+		},
+	}
+	c.Flags().StringVar(&rangeArg, flagRange, "",
+		"date range like 2026-Jul-01:2026-Sep-30 (default is the last 30 days)")
+	c.Flags().StringVar(&groupBy, flagGroupBy, report.GroupByNone.String(),
+		"group issues by "+report.GroupByNames(flowGroupBys...))
+	return c
+}
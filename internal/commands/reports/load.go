@@ -0,0 +1,61 @@
+package reports
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newLoadCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		rangeArg string
+		useColor bool
+		dr       *utils.DayRange
+	)
+	const flagRange = "range"
+	c := &cobra.Command{
+		Use:   "load",
+		Short: "Show how many open epics and stories each assignee has per week",
+		Long: `Show how many open epics and stories each assignee has per week.
+
+Each cell counts the assignee's epics and stories whose start and
+end dates overlap the week.  Issues without both dates are counted
+as undated.  Unassigned work gets its own row.`,
+		Example: `
+   report load
+   report load --` + flagRange + ` 3m
+`,
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return fmt.Errorf("unexpected arguments %v", args)
+			}
+			dayCount, err := utils.ConvertToDayCount(rangeArg)
+			if err != nil {
+				return fmt.Errorf("invalid --%s %q; %w", flagRange, rangeArg, err)
+			}
+			dr, err = utils.MakeDayRangeSimple(utils.Today().BackToMonday(), dayCount)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			epics := jb.GetFilteredEpics(&myj.EpicFilter{HideDone: true})
+			var issues myj.IssueList
+			for _, epic := range epics {
+				issues = append(issues, epic)
+			}
+			for _, stories := range jb.GetIssuesGroupedByEpic(epics) {
+				issues = append(issues, stories...)
+			}
+			report.DoLoad(os.Stdout, issues, dr, useColor)
+			return nil
+		},
+	}
+	c.Flags().StringVar(&rangeArg, flagRange, "1m",
+		"how far ahead to look, e.g. 6w or 3m")
+	c.Flags().BoolVar(&useColor, "color", true, "use colors")
+	return c
+}
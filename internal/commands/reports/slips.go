@@ -0,0 +1,38 @@
+package reports
+
+import (
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/spf13/cobra"
+)
+
+func newSlipsCmd(jb *myj.JiraBoss) *cobra.Command {
+	var filter myj.EpicFilter
+	c := &cobra.Command{
+		Use:   "slips",
+		Short: "Rank epics by how many days their end dates have slipped",
+		Example: `
+To rank the epics that aren't done yet:
+
+   report slips --hide-done
+
+The history column is a sparkline of the most recent end date changes.
+Use the 'history' command to see the details for any one epic.
+`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			epicMap := jb.GetFilteredEpics(&filter)
+			if err := jb.LoadChangelogs(epicMap); err != nil {
+				return err
+			}
+			report.DoSlips(os.Stdout, epicMap)
+			return nil
+		},
+	}
+	c.Flags().BoolVar(&filter.HideDone, "hide-done", false,
+		"hide epics that are done or closed")
+	return c
+}
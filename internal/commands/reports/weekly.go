@@ -0,0 +1,75 @@
+package reports
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/report"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var weeklyFormats = []report.Format{report.FormatMarkdown, report.FormatHtml}
+
+func newWeeklyCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		epics    []int
+		rangeArg string
+		format   string
+		dr       *utils.DayRange
+		f        report.Format
+	)
+	const (
+		flagRange  = "range"
+		flagFormat = "format"
+	)
+	c := &cobra.Command{
+		Use:   "weekly [epicNum]...",
+		Short: "Write a status report for each epic",
+		Long: `Write a status report for each epic.
+
+For each epic, list the stories completed and newly blocked during the
+range, the stories in progress, and any changes to the epic's dates.
+An epic whose target date has passed, but isn't done, is flagged at risk.`,
+		Example: `
+To report on all epics that aren't done, for this week so far:
+
+   report weekly
+
+To report on epics 120 and 130 for the first week of October, as html:
+
+   report weekly 120 130 --` + flagRange + ` 2026-Oct-05:2026-Oct-09 --` + flagFormat + ` html
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if epics, err = utils.ConvertToInt(args); err != nil {
+				return err
+			}
+			if rangeArg == "" {
+				today := utils.Today()
+				dr, err = utils.MakeDayRangeGentle(today.BackToMonday(), today)
+			} else {
+				dr, err = utils.MakeRangeFromStringPair(rangeArg)
+			}
+			if err != nil {
+				return fmt.Errorf("invalid --%s %q; %w", flagRange, rangeArg, err)
+			}
+			f, err = report.ParseFormat(format, weeklyFormats...)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			weeks, err := jb.GetEpicWeeks(epics, dr)
+			if err != nil {
+				return err
+			}
+			report.DoWeekly(os.Stdout, jb.Project(), weeks, dr, f)
+			return nil
+		},
+	}
+	c.Flags().StringVar(&rangeArg, flagRange, "",
+		"date range like 2026-Oct-05:2026-Oct-09 (default is this week so far)")
+	c.Flags().StringVar(&format, flagFormat, report.FormatMarkdown.String(),
+		"output format, one of "+report.FormatNames(weeklyFormats...))
+	return c
+}
@@ -0,0 +1,107 @@
+package selection
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/pflag"
+)
+
+const (
+	FlagJql    = "jql"
+	FlagFilter = "filter"
+	flagYes    = "yes"
+
+	// confirmAbove is how many selected issues it takes
+	// to ask before going on.
+	confirmAbove = 10
+)
+
+// Selection lets a command act on the issues matched by a query
+// or saved filter, as well as those given by number.
+type Selection struct {
+	jql    string
+	filter int
+	yes    bool
+	// readOnly commands don't ask before going on.
+	readOnly bool
+	// ask puts a question to the user, returning the answer in lower case.
+	ask func(question string) string
+}
+
+// New returns a Selection; readOnly is true for commands that
+// don't change the issues.
+func New(readOnly bool) *Selection {
+	return &Selection{readOnly: readOnly, ask: utils.Ask}
+}
+
+// AddFlags adds the selection flags to the command's flags.
+func (s *Selection) AddFlags(set *pflag.FlagSet) {
+	set.StringVar(&s.jql, FlagJql, "",
+		"also act on the project's issues matching this query")
+	set.IntVar(&s.filter, FlagFilter, 0,
+		"also act on the project's issues matching this saved filter")
+	if !s.readOnly {
+		set.BoolVar(&s.yes, flagYes, false, fmt.Sprintf(
+			"don't ask before acting on more than %d selected issues", confirmAbove))
+	}
+}
+
+// IsSet is true if a query or filter was given, in which case
+// the command needn't be given issue numbers.
+func (s *Selection) IsSet() bool {
+	return s.jql != "" || s.filter != 0
+}
+
+// Usage is a line for a command's help.
+const Usage = `Instead of, or as well as, issue numbers, give --` + FlagJql +
+	` "{query}"
+or --` + FlagFilter + ` {savedFilterId} to act on the project's issues they match.`
+
+// Issues returns the given issues plus those the query or filter
+// selects, without repeats.  If there are many, the user is asked
+// before going on.
+func (s *Selection) Issues(jb *myj.JiraBoss, given []int) ([]int, error) {
+	if !s.IsSet() {
+		return given, nil
+	}
+	if s.jql != "" && s.filter != 0 {
+		return nil, fmt.Errorf("specify only one of --%s and --%s", FlagJql, FlagFilter)
+	}
+	jql := s.jql
+	if s.filter != 0 {
+		var err error
+		if jql, err = jb.GetFilterJql(s.filter); err != nil {
+			return nil, err
+		}
+	}
+	selected, err := jb.SelectIssues(jql)
+	if err != nil {
+		return nil, err
+	}
+	utils.DoErrF("The query selects %d issues.\n", len(selected))
+	return s.combine(given, selected)
+}
+
+// combine adds the selected issues to the given ones, without repeats.
+// If there are many, the user is asked before going on.
+func (s *Selection) combine(given, selected []int) ([]int, error) {
+	result := slices.Clone(given)
+	for _, n := range selected {
+		if !slices.Contains(result, n) {
+			result = append(result, n)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no issues selected")
+	}
+	if !s.readOnly && !s.yes && len(result) > confirmAbove {
+		answer := s.ask(fmt.Sprintf("Act on %d issues? [y/N] ", len(result)))
+		if answer != "y" && answer != "yes" {
+			return nil, fmt.Errorf("stopped; add --%s to skip asking", flagYes)
+		}
+	}
+	return result, nil
+}
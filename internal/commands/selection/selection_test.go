@@ -0,0 +1,95 @@
+package selection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// count returns the numbers from one through n.
+func count(n int) []int {
+	result := make([]int, n)
+	for i := range result {
+		result[i] = i + 1
+	}
+	return result
+}
+
+func TestCombine(t *testing.T) {
+	type testCase struct {
+		given       []int
+		selected    []int
+		readOnly    bool
+		yes         bool
+		answer      string
+		expect      []int
+		expectAsked bool
+		expectErr   bool
+	}
+	tests := map[string]testCase{
+		"given then selected": {
+			given:    []int{7, 3},
+			selected: []int{5, 1},
+			expect:   []int{7, 3, 5, 1},
+		},
+		"no repeats": {
+			given:    []int{3, 5},
+			selected: []int{5, 9, 3, 9},
+			expect:   []int{3, 5, 9},
+		},
+		"only selected": {
+			selected: []int{4},
+			expect:   []int{4},
+		},
+		"nothing": {
+			expectErr: true,
+		},
+		"at the threshold, no question": {
+			given:    count(6),
+			selected: count(confirmAbove),
+			expect:   count(confirmAbove),
+		},
+		"above the threshold, yes": {
+			given:       []int{11},
+			selected:    count(confirmAbove),
+			answer:      "y",
+			expect:      append([]int{11}, count(confirmAbove)...),
+			expectAsked: true,
+		},
+		"above the threshold, no": {
+			selected:    count(confirmAbove + 1),
+			answer:      "",
+			expectAsked: true,
+			expectErr:   true,
+		},
+		"above the threshold, --yes": {
+			selected: count(confirmAbove + 1),
+			yes:      true,
+			expect:   count(confirmAbove + 1),
+		},
+		"above the threshold, read only": {
+			selected: count(confirmAbove + 1),
+			readOnly: true,
+			expect:   count(confirmAbove + 1),
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			asked := false
+			s := New(tc.readOnly)
+			s.yes = tc.yes
+			s.ask = func(string) string {
+				asked = true
+				return tc.answer
+			}
+			result, err := s.combine(tc.given, tc.selected)
+			assert.Equal(t, tc.expectAsked, asked)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, result)
+		})
+	}
+}
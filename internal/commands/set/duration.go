@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/monopole/gojira/internal/commands/selection"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ func newDurationCmd(jb *myj.JiraBoss) *cobra.Command {
 	)
 	var (
 		issues   []int
+		sel      = selection.New(false)
 		dayCount int
 		delta    bool
 	)
@@ -39,9 +41,11 @@ func newDurationCmd(jb *myj.JiraBoss) *cobra.Command {
 
     set duration +1m  99       // add one month to existing duration
     set duration -- -2w  99    // subtract two weeks from existing duration
+
+` + selection.Usage + `
 `,
 		Args: func(_ *cobra.Command, args []string) (err error) {
-			if len(args) < 2 {
+			if len(args) < 2 && !(len(args) == 1 && sel.IsSet()) {
 				return fmt.Errorf("specify a date and issue number")
 			}
 			argZero := strings.TrimPrefix(args[0], "-")
@@ -66,6 +70,10 @@ func newDurationCmd(jb *myj.JiraBoss) *cobra.Command {
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			var err error
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
 			for _, issue := range issues {
 				record, err := jb.GetOneIssue(issue)
 				if err != nil {
@@ -91,5 +99,6 @@ func newDurationCmd(jb *myj.JiraBoss) *cobra.Command {
 			return nil
 		},
 	}
+	sel.AddFlags(c.Flags())
 	return c
 }
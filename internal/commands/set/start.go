@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/monopole/gojira/internal/commands/selection"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
 	"github.com/spf13/cobra"
@@ -13,6 +14,7 @@ import (
 func newStartCmd(jb *myj.JiraBoss) *cobra.Command {
 	var (
 		issues []int
+		sel    = selection.New(false)
 		start  utils.Date
 	)
 	const defaultWeeks = 4
@@ -33,9 +35,11 @@ func newStartCmd(jb *myj.JiraBoss) *cobra.Command {
   a default duration of ` + strconv.Itoa(defaultWeeks) + ` weeks.
 
   If desired, use 'set duration' to set a different duration value.
+
+` + selection.Usage + `
 `,
 		Args: func(_ *cobra.Command, args []string) (err error) {
-			if len(args) < 2 {
+			if len(args) < 2 && !(len(args) == 1 && sel.IsSet()) {
 				return fmt.Errorf("specify a date and issue number")
 			}
 			start, err = utils.ParseDate(args[0])
@@ -53,6 +57,10 @@ func newStartCmd(jb *myj.JiraBoss) *cobra.Command {
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			var err error
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
 			for _, issue := range issues {
 				record, err := jb.GetOneIssue(issue)
 				if err != nil {
@@ -77,5 +85,6 @@ func newStartCmd(jb *myj.JiraBoss) *cobra.Command {
 			return nil
 		},
 	}
+	sel.AddFlags(c.Flags())
 	return c
 }
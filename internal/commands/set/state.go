@@ -2,48 +2,89 @@ package set
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/monopole/gojira/internal/commands/selection"
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 func newStateCmd(jb *myj.JiraBoss) *cobra.Command {
-	var issues []int
-	var status myj.IssueStatus
+	var (
+		issues []int
+		sel    = selection.New(false)
+		status string
+		opts   myj.TransitionOptions
+	)
 	c := &cobra.Command{
 		Use:   "state {state} {issueNum}...",
 		Short: "Move the given issues to a new state",
+		Long: `Move the given issues to a new state.
+
+If there's no direct transition to the new state, the shortest
+path of transitions through the workflow is taken.  Transitions
+requiring a resolution get the one given by --resolution (by default
+"Done", if allowed).  Transitions requiring a comment get --comment.`,
 		Example: `
-   set status "In Queue" 12 33 45 
+   set state "In Queue" 12 33 45 
+
+   set state Done 100 200 300
+
+   set state Done 99 --dry-run
+
+   set state "Closed Without Action" 99 --resolution "Won't Do" --comment "obsolete"
 
-   set status Done 100 200 300
+` + selection.Usage + `
 `,
 		Args: func(_ *cobra.Command, args []string) (err error) {
-			if len(args) < 2 {
+			if len(args) < 2 && !(len(args) == 1 && sel.IsSet()) {
 				return fmt.Errorf(
 					"specify new state in quotes and issue number(s)")
 			}
-			status, err = myj.IssueStatusString(args[0])
-			if err != nil {
-				return err
-			}
+			status = args[0]
 			issues, err = utils.ConvertToInt(args[1:])
 			return err
 		},
 		SilenceUsage: true,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			var err error
+			if issues, err = sel.Issues(jb, issues); err != nil {
+				return err
+			}
+			catalog, err := jb.GetCatalog()
+			if err != nil {
+				return err
+			}
+			info, ok := catalog.Status(status)
+			if !ok {
+				return fmt.Errorf("unknown state %q; use one of %s",
+					status, strings.Join(catalog.StatusNames(), ", "))
+			}
+			status = info.Name
 			for _, issue := range issues {
-				id, err := jb.GetTransitionId(issue, status)
+				path, err := jb.FindTransitionPath(issue, status)
 				if err != nil {
 					return err
 				}
-				err = jb.MoveIssueToState(issue, id)
-				if err != nil {
+				if len(path) == 0 {
+					utils.DoErrF("%s is already %s\n", jb.Key(issue), status)
+					continue
+				}
+				for _, step := range path {
+					utils.DoErrF("%s: %s\n", jb.Key(issue), step)
+				}
+				if err = jb.MoveIssueAlongPath(issue, path, &opts); err != nil {
 					return err
 				}
 			}
 			return nil
 		},
 	}
+	c.Flags().StringVar(&opts.Resolution, "resolution", "",
+		"resolution to use if a transition requires one")
+	c.Flags().StringVar(&opts.Comment, "comment", "",
+		"comment to add when making the transitions")
+	sel.AddFlags(c.Flags())
 	return c
 }
@@ -0,0 +1,70 @@
+package sprint
+
+import (
+	"fmt"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newAddCmd(jb *myj.JiraBoss) *cobra.Command {
+	var (
+		issues []int
+		sprint int
+	)
+	c := &cobra.Command{
+		Use:   "add {sprintId} {issueNum}...",
+		Short: "Move issues into a sprint",
+		Example: `
+To move issues 111 and 118 into sprint 1234 enter:
+
+    sprint add 1234 111 118
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) < 2 {
+				return fmt.Errorf(
+					"specify a sprint id and at least one issue number")
+			}
+			issues, err = utils.ConvertToInt(args)
+			if err != nil {
+				return err
+			}
+			sprint = issues[0]
+			issues = issues[1:]
+			return nil
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return jb.AddToSprint(sprint, issues)
+		},
+	}
+	return c
+}
+
+func newRemoveCmd(jb *myj.JiraBoss) *cobra.Command {
+	var issues []int
+	c := &cobra.Command{
+		Use:   "remove {issueNum}...",
+		Short: "Move issues out of their sprint and back to the backlog",
+		Example: `
+This undoes the work of the 'add' command.
+
+To move issues 111 and 118 back to the backlog enter:
+
+    sprint remove 111 118
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) < 1 {
+				return fmt.Errorf("specify at least one issue number")
+			}
+			issues, err = utils.ConvertToInt(args)
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return jb.RemoveFromSprint(issues)
+		},
+	}
+	return c
+}
@@ -0,0 +1,26 @@
+package sprint
+
+import (
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/spf13/cobra"
+)
+
+const flagBoard = "board"
+
+func NewSprintCmd(jb *myj.JiraBoss) *cobra.Command {
+	var board int
+	c := &cobra.Command{
+		Use:          "sprint",
+		Short:        "Perform operations involving sprints",
+		SilenceUsage: true,
+	}
+	c.AddCommand(
+		newListCmd(jb, &board),
+		newShowCmd(jb, &board),
+		newAddCmd(jb),
+		newRemoveCmd(jb),
+	)
+	c.PersistentFlags().IntVar(&board, flagBoard, 0,
+		"id of the agile board holding the sprints (default is the project's first scrum board)")
+	return c
+}
@@ -0,0 +1,72 @@
+package sprint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(jb *myj.JiraBoss, board *int) *cobra.Command {
+	var states []string
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the sprints on a board",
+		Example: `
+  List the active and future sprints:
+
+    sprint list
+
+  List all sprints, including closed sprints, on board 77:
+
+    sprint list --board 77 --state active,future,closed
+`,
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return fmt.Errorf("this command takes no arguments")
+			}
+			for _, s := range states {
+				switch s {
+				case myj.SprintStateActive, myj.SprintStateFuture, myj.SprintStateClosed:
+				default:
+					return fmt.Errorf("unknown sprint state %q", s)
+				}
+			}
+			return nil
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			id, err := jb.FindBoard(*board)
+			if err != nil {
+				return err
+			}
+			sprints, err := jb.GetSprints(id, states...)
+			if err != nil {
+				return err
+			}
+			for i := range sprints {
+				printSprint(&sprints[i])
+			}
+			return nil
+		},
+	}
+	c.Flags().StringSliceVar(&states, "state",
+		[]string{myj.SprintStateActive, myj.SprintStateFuture},
+		"sprint states to list ("+strings.Join([]string{
+			myj.SprintStateActive,
+			myj.SprintStateFuture,
+			myj.SprintStateClosed}, ", ")+")")
+	return c
+}
+
+func printSprint(s *myj.Sprint) {
+	fmt.Printf("%6d %-8s %s\n", s.Id, "("+s.State+")", s.Name)
+	if dr, err := s.DayRange(); err == nil {
+		fmt.Printf("%15s %s\n", "", dr.PrettyRange())
+	}
+	if s.Goal != "" {
+		fmt.Printf("%15s %s\n", "", utils.Ellipsis(s.Goal, 70))
+	}
+}
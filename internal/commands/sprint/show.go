@@ -0,0 +1,66 @@
+package sprint
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/spf13/cobra"
+)
+
+func newShowCmd(jb *myj.JiraBoss, board *int) *cobra.Command {
+	var sprintId int
+	c := &cobra.Command{
+		Use:   "show [{sprintId}]",
+		Short: "Show the issues in a sprint",
+		Example: `
+  Show the issues in the active sprint:
+
+    sprint show
+
+  Show the issues in sprint 1234 (get ids from 'sprint list'):
+
+    sprint show 1234
+`,
+		Args: func(_ *cobra.Command, args []string) (err error) {
+			if len(args) > 1 {
+				return fmt.Errorf("specify at most one sprint id")
+			}
+			if len(args) == 1 {
+				sprintId, err = strconv.Atoi(args[0])
+			}
+			return err
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			var (
+				sprint *myj.Sprint
+				err    error
+			)
+			if sprintId > 0 {
+				sprint, err = jb.GetSprint(sprintId)
+			} else {
+				var id int
+				if id, err = jb.FindBoard(*board); err != nil {
+					return err
+				}
+				sprint, err = jb.GetActiveSprint(id)
+			}
+			if err != nil {
+				return err
+			}
+			issues, err := jb.GetSprintIssues(sprint.Id)
+			if err != nil {
+				return err
+			}
+			printSprint(sprint)
+			fmt.Println()
+			for i := range issues {
+				issues[i].SpewParsable(os.Stdout, true, 1)
+			}
+			return nil
+		},
+	}
+	return c
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+func newTuiCmd(jb *myj.JiraBoss) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "tui",
+		Short: "Browse and edit epics and their stories in a full screen view",
+		Long: `Browse and edit epics and their stories in a full screen view.
+
+Move with j/k or the arrow keys, and expand an epic with enter to
+see its stories.  Keys act on the highlighted issue:
+
+  r  rename              s  change status
+  d  change dates        l  add or remove a label
+  m  move to an epic     a  assign
+  c  toggle calendar     g  reload
+  q  quit
+
+Changes are written to jira immediately, and the view reloads.`,
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return fmt.Errorf("this command takes no arguments")
+			}
+			return nil
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return tui.Run(jb)
+		},
+	}
+	return c
+}
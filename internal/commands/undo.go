@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/spf13/cobra"
+)
+
+const undoHelp = "Undo writes recorded in the journal"
+
+func newUndoCmd(jb *myj.JiraBoss, jiraArgs *myj.MyJiraArgs) *cobra.Command {
+	const (
+		flagLast    = "last"
+		flagSession = "session"
+		flagDoIt    = "go"
+		maxSessions = 10
+	)
+	var (
+		last    int
+		session string
+		doIt    bool
+	)
+	c := &cobra.Command{
+		Use:   "undo",
+		Short: undoHelp,
+		Long: undoHelp + `.
+
+Every write gojira makes is recorded in a journal, along with the
+values it overwrote.  With no flags, this lists recent sessions
+(runs of gojira that wrote something).
+
+With --` + flagLast + ` N, the last N writes are undone, newest first.
+Writes already undone are skipped, so repeating the command undoes
+further back.  With --` + flagSession + ` ID, all of a session's writes
+are undone; undoing a session of undos redoes the writes.
+
+Field changes (titles, dates, labels, epic links, assignees) are
+restored, deleted links and comments are recreated, added ones are
+deleted, sprint moves are reversed, and status changes are reverted
+where the workflow allows.
+
+Nothing is written without --` + flagDoIt + `.`,
+		Example: `  gojira undo
+  gojira undo --` + flagLast + ` 3 --` + flagDoIt + `
+  gojira undo --` + flagSession + ` 20261019-142501-4242 --` + flagDoIt,
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return fmt.Errorf("this command takes no arguments")
+			}
+			if last < 0 {
+				return fmt.Errorf("--%s must be positive", flagLast)
+			}
+			if last > 0 && session != "" {
+				return fmt.Errorf("specify only one of --%s and --%s", flagLast, flagSession)
+			}
+			if jiraArgs.JournalPath == "" {
+				return fmt.Errorf("the journal is off")
+			}
+			return nil
+		},
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			entries, err := myj.ReadJournal(jiraArgs.JournalPath)
+			if err != nil {
+				return err
+			}
+			if last == 0 && session == "" {
+				sessions := myj.JournalSessions(entries)
+				if len(sessions) == 0 {
+					fmt.Println("The journal is empty.")
+				}
+				for _, s := range sessions[max(0, len(sessions)-maxSessions):] {
+					fmt.Printf("%s  %3d writes  gojira %s\n",
+						s.Id, len(s.Entries), s.Command)
+				}
+				return nil
+			}
+			todo, err := myj.SelectForUndo(entries, last, session)
+			if err != nil {
+				return err
+			}
+			if len(todo) == 0 {
+				fmt.Println("Nothing to undo.")
+				return nil
+			}
+			for i := range todo {
+				fmt.Println(todo[i].String())
+			}
+			if !doIt && !jb.DryRun() {
+				return fmt.Errorf("add --%s to undo these %d writes", flagDoIt, len(todo))
+			}
+			for i := range todo {
+				if err = jb.Undo(&todo[i]); err != nil {
+					return err
+				}
+			}
+			fmt.Printf("Undid %d writes.\n", len(todo))
+			return nil
+		},
+	}
+	c.Flags().IntVar(&last, flagLast, 0, "undo the last N writes")
+	c.Flags().StringVar(&session, flagSession, "", "undo all the writes of a session")
+	c.Flags().BoolVar(&doIt, flagDoIt, false, "actually undo")
+	return c
+}
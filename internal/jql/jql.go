@@ -0,0 +1,304 @@
+package jql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// Value is the right side of a term, already in JQL form; make one
+// with Str, Strs, Date, Func or Empty, so it's quoted correctly.
+type Value string
+
+// Empty matches fields with no value, used with OpIs and OpIsNot.
+const Empty Value = "EMPTY"
+
+// Quote makes s a JQL string literal.
+func Quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// Str is a quoted string.
+func Str(s string) Value {
+	return Value(Quote(s))
+}
+
+// Strs is a parenthesized list of quoted strings, for OpIn and OpNotIn.
+func Strs(s ...string) Value {
+	quoted := make([]string, len(s))
+	for i := range s {
+		quoted[i] = Quote(s[i])
+	}
+	return Value("(" + strings.Join(quoted, ", ") + ")")
+}
+
+// Date is a quoted day.
+func Date(d utils.Date) Value {
+	return Str(d.JiraFormat())
+}
+
+// Func is a call to a JQL function, e.g. Func("startOfDay", "-7d").
+// The arguments are quoted.
+func Func(name string, args ...string) Value {
+	quoted := make([]string, len(args))
+	for i := range args {
+		quoted[i] = Quote(args[i])
+	}
+	return Value(name + "(" + strings.Join(quoted, ", ") + ")")
+}
+
+// By narrows an OpWas value to changes made by the user.
+func (v Value) By(user string) Value {
+	return v + " BY " + Str(user)
+}
+
+// During narrows an OpWas value to changes made between the values.
+func (v Value) During(from, to Value) Value {
+	return v + " DURING (" + from + ", " + to + ")"
+}
+
+// Some common functions.
+var (
+	Now         = Func("now")
+	CurrentUser = Func("currentUser")
+)
+
+// StartOfDay is the start of the day offset from today,
+// e.g. StartOfDay(-7) is a week ago.
+func StartOfDay(days int) Value {
+	if days == 0 {
+		return Func("startOfDay")
+	}
+	return Func("startOfDay", signedDays(days))
+}
+
+func signedDays(days int) string {
+	s := strconv.Itoa(days) + "d"
+	if days > 0 {
+		s = "+" + s
+	}
+	return s
+}
+
+// kind says how a Clause was made, to know when it needs parens.
+type kind int
+
+const (
+	kindNone kind = iota
+	kindTerm
+	kindAnd
+	kindOr
+	kindNot
+	kindRaw
+)
+
+// Clause is a condition on issues.  The zero Clause is no condition,
+// and is dropped when combined with others.
+type Clause struct {
+	text string
+	kind kind
+}
+
+func (c Clause) String() string {
+	return c.text
+}
+
+// IsZero is true if the clause is no condition.
+func (c Clause) IsZero() bool {
+	return c.kind == kindNone
+}
+
+// simpleField matches field names that needn't be quoted.
+var simpleField = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_.]*|cf\[[0-9]+\])$`)
+
+func field(name string) string {
+	if simpleField.MatchString(name) {
+		return name
+	}
+	return Quote(name)
+}
+
+// Term compares a field to a value.
+func Term(name string, op Op, v Value) Clause {
+	return Clause{text: field(name) + " " + op.String() + " " + string(v), kind: kindTerm}
+}
+
+// Eq is a field equal to a string.
+func Eq(name, value string) Clause {
+	return Term(name, OpEqual, Str(value))
+}
+
+// NotEq is a field not equal to a string.
+func NotEq(name, value string) Clause {
+	return Term(name, OpNotEqual, Str(value))
+}
+
+// In is a field equal to any of the strings.  With one string, it's Eq;
+// with none, it's no condition.
+func In(name string, values ...string) Clause {
+	switch len(values) {
+	case 0:
+		return Clause{}
+	case 1:
+		return Eq(name, values[0])
+	}
+	return Term(name, OpIn, Strs(values...))
+}
+
+// Contains is a text search of a field.
+func Contains(name, text string) Clause {
+	return Term(name, OpContains, Str(text))
+}
+
+// IsEmpty is a field with no value.
+func IsEmpty(name string) Clause {
+	return Term(name, OpIs, Empty)
+}
+
+// IsNotEmpty is a field with some value.
+func IsNotEmpty(name string) Clause {
+	return Term(name, OpIsNot, Empty)
+}
+
+// IssueFunction is a search with a ScriptRunner function, e.g.
+// IssueFunction("commented", "by jdoe after 2026-03-02").
+func IssueFunction(name string, args ...string) Clause {
+	return Term("issueFunction", OpIn, Func(name, args...))
+}
+
+// Raw is JQL written elsewhere, e.g. by a user.  Any ORDER BY
+// is dropped, since it can't be part of a larger condition.
+func Raw(s string) Clause {
+	s = strings.TrimSpace(s[:orderByIndex(s)])
+	if s == "" {
+		return Clause{}
+	}
+	return Clause{text: s, kind: kindRaw}
+}
+
+// orderBy matches the start of an ORDER BY clause.
+var orderBy = regexp.MustCompile(`(?i)^order\s+by\b`)
+
+// orderByIndex returns where the ORDER BY that may end the query
+// starts, or the query's length if it has none.  An "order by"
+// in a quoted string doesn't count.
+func orderByIndex(s string) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case (i == 0 || !isWordByte(s[i-1])) && orderBy.MatchString(s[i:]):
+			return i
+		}
+	}
+	return len(s)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || ('0' <= c && c <= '9') ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// And is all the clauses.
+func And(cs ...Clause) Clause {
+	return join(kindAnd, " AND ", cs)
+}
+
+// Or is any of the clauses.
+func Or(cs ...Clause) Clause {
+	return join(kindOr, " OR ", cs)
+}
+
+func join(k kind, sep string, cs []Clause) Clause {
+	var parts []string
+	for _, c := range cs {
+		if c.IsZero() {
+			continue
+		}
+		parts = append(parts, c.within(k))
+	}
+	switch len(parts) {
+	case 0:
+		return Clause{}
+	case 1:
+		// Nothing to join; keep the lone clause as it was.
+		for _, c := range cs {
+			if !c.IsZero() {
+				return c
+			}
+		}
+	}
+	return Clause{text: strings.Join(parts, sep), kind: k}
+}
+
+// Not is the opposite of the clause.
+func Not(c Clause) Clause {
+	if c.IsZero() {
+		return c
+	}
+	return Clause{text: "NOT " + c.within(kindNot), kind: kindNot}
+}
+
+// within returns the clause's text, in parens if it's to be part
+// of a clause of the given kind and might otherwise bind wrongly.
+func (c Clause) within(outer kind) string {
+	switch {
+	case c.kind == kindTerm, c.kind == kindNot:
+		return c.text
+	case c.kind == outer && outer != kindNot:
+		return c.text
+	}
+	return "(" + c.text + ")"
+}
+
+// Order is one key of an ORDER BY.
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// ParseOrder reads e.g. "updated" or "-updated", the latter
+// meaning descending.
+func ParseOrder(s string) Order {
+	if strings.HasPrefix(s, "-") {
+		return Order{Field: s[1:], Desc: true}
+	}
+	return Order{Field: s}
+}
+
+// Query is a whole query; a condition and an order.
+type Query struct {
+	Where   Clause
+	OrderBy []Order
+}
+
+func (q Query) String() string {
+	var b strings.Builder
+	b.WriteString(q.Where.String())
+	for i, o := range q.OrderBy {
+		if i == 0 {
+			if b.Len() > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString("ORDER BY ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(field(o.Field))
+		if o.Desc {
+			b.WriteString(" DESC")
+		}
+	}
+	return b.String()
+}
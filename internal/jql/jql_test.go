@@ -0,0 +1,118 @@
+package jql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, `"plain"`, Quote("plain"))
+	assert.Equal(t, `"say \"hi\""`, Quote(`say "hi"`))
+	assert.Equal(t, `"back\\slash"`, Quote(`back\slash`))
+}
+
+func TestClauses(t *testing.T) {
+	tests := map[string]struct {
+		c    Clause
+		want string
+	}{
+		"term": {
+			c:    Eq("project", "PEACH"),
+			want: `project = "PEACH"`,
+		},
+		"odd field": {
+			c:    Eq("Epic Link", "PEACH-1"),
+			want: `"Epic Link" = "PEACH-1"`,
+		},
+		"custom field": {
+			c:    IsEmpty("cf[10010]"),
+			want: `cf[10010] is EMPTY`,
+		},
+		"in": {
+			c:    In("labels", "a", "b c"),
+			want: `labels in ("a", "b c")`,
+		},
+		"in one": {
+			c:    In("labels", "a"),
+			want: `labels = "a"`,
+		},
+		"in none": {
+			c: In("labels"),
+		},
+		"and drops zero": {
+			c:    And(Eq("a", "1"), In("b"), Eq("c", "3")),
+			want: `a = "1" AND c = "3"`,
+		},
+		"or within and": {
+			c:    And(Eq("a", "1"), Or(Eq("b", "2"), Eq("c", "3"))),
+			want: `a = "1" AND (b = "2" OR c = "3")`,
+		},
+		"and within and": {
+			c:    And(And(Eq("a", "1"), Eq("b", "2")), Eq("c", "3")),
+			want: `a = "1" AND b = "2" AND c = "3"`,
+		},
+		"not": {
+			c:    Not(Or(Eq("a", "1"), Eq("b", "2"))),
+			want: `NOT (a = "1" OR b = "2")`,
+		},
+		"raw": {
+			c:    And(Eq("a", "1"), Raw(`b = 2 OR c = 3 order by rank`)),
+			want: `a = "1" AND (b = 2 OR c = 3)`,
+		},
+		"raw quoted order by": {
+			c: And(Eq("a", "1"),
+				Raw(`summary ~ "sort order by date" or summary ~ 'it\'s order by' ORDER BY key`)),
+			want: `a = "1" AND (summary ~ "sort order by date" or summary ~ 'it\'s order by')`,
+		},
+		"raw reorder": {
+			c:    Raw(`labels = reorder`),
+			want: `labels = reorder`,
+		},
+		"raw only order by": {
+			c: Raw(`ORDER BY rank`),
+		},
+		"contains": {
+			c:    Contains("text", `50" pipe`),
+			want: `text ~ "50\" pipe"`,
+		},
+		"date": {
+			c: Term("updated", OpGreaterOrEqual,
+				Date(utils.MakeDate(2026, time.March, 2))),
+			want: `updated >= "2026-03-02"`,
+		},
+		"function": {
+			c:    Term("updated", OpGreaterOrEqual, StartOfDay(-7)),
+			want: `updated >= startOfDay("-7d")`,
+		},
+		"issue function": {
+			c:    IssueFunction("commented", `by jdoe after 2026-03-02`),
+			want: `issueFunction in commented("by jdoe after 2026-03-02")`,
+		},
+		"was by during": {
+			c: Term("status", OpWas, Str("Resolved").By("j doe").During(
+				Date(utils.MakeDate(2026, time.March, 2)), Now)),
+			want: `status was "Resolved" BY "j doe" DURING ("2026-03-02", now())`,
+		},
+		"current user": {
+			c:    Term("assignee", OpEqual, CurrentUser),
+			want: `assignee = currentUser()`,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.c.String())
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	q := Query{
+		Where:   Eq("project", "P"),
+		OrderBy: []Order{ParseOrder("-updated"), ParseOrder("key")},
+	}
+	assert.Equal(t, `project = "P" ORDER BY updated DESC, key`, q.String())
+	assert.Equal(t, `ORDER BY key`, Query{OrderBy: []Order{{Field: "key"}}}.String())
+}
@@ -0,0 +1,21 @@
+package jql
+
+//go:generate go run github.com/dmarkham/enumer -linecomment -type=Op
+type Op int
+
+const (
+	OpUnknown        Op = iota
+	OpEqual             // =
+	OpNotEqual          // !=
+	OpLess              // <
+	OpLessOrEqual       // <=
+	OpGreater           // >
+	OpGreaterOrEqual    // >=
+	OpContains          // ~
+	OpNotContains       // !~
+	OpIn                // in
+	OpNotIn             // not in
+	OpIs                // is
+	OpIsNot             // is not
+	OpWas               // was
+)
@@ -0,0 +1,126 @@
+// Code generated by "enumer -linecomment -type=Op"; DO NOT EDIT.
+
+package jql
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _OpName = "OpUnknown=!=<<=>>=~!~innot inisis notwas"
+
+var _OpIndex = [...]uint8{0, 9, 10, 12, 13, 15, 16, 18, 19, 21, 23, 29, 31, 37, 40}
+
+const _OpLowerName = "opunknown=!=<<=>>=~!~innot inisis notwas"
+
+func (i Op) String() string {
+	if i < 0 || i >= Op(len(_OpIndex)-1) {
+		return fmt.Sprintf("Op(%d)", i)
+	}
+	return _OpName[_OpIndex[i]:_OpIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _OpNoOp() {
+	var x [1]struct{}
+	_ = x[OpUnknown-(0)]
+	_ = x[OpEqual-(1)]
+	_ = x[OpNotEqual-(2)]
+	_ = x[OpLess-(3)]
+	_ = x[OpLessOrEqual-(4)]
+	_ = x[OpGreater-(5)]
+	_ = x[OpGreaterOrEqual-(6)]
+	_ = x[OpContains-(7)]
+	_ = x[OpNotContains-(8)]
+	_ = x[OpIn-(9)]
+	_ = x[OpNotIn-(10)]
+	_ = x[OpIs-(11)]
+	_ = x[OpIsNot-(12)]
+	_ = x[OpWas-(13)]
+}
+
+var _OpValues = []Op{OpUnknown, OpEqual, OpNotEqual, OpLess, OpLessOrEqual, OpGreater, OpGreaterOrEqual, OpContains, OpNotContains, OpIn, OpNotIn, OpIs, OpIsNot, OpWas}
+
+var _OpNameToValueMap = map[string]Op{
+	_OpName[0:9]:        OpUnknown,
+	_OpLowerName[0:9]:   OpUnknown,
+	_OpName[9:10]:       OpEqual,
+	_OpLowerName[9:10]:  OpEqual,
+	_OpName[10:12]:      OpNotEqual,
+	_OpLowerName[10:12]: OpNotEqual,
+	_OpName[12:13]:      OpLess,
+	_OpLowerName[12:13]: OpLess,
+	_OpName[13:15]:      OpLessOrEqual,
+	_OpLowerName[13:15]: OpLessOrEqual,
+	_OpName[15:16]:      OpGreater,
+	_OpLowerName[15:16]: OpGreater,
+	_OpName[16:18]:      OpGreaterOrEqual,
+	_OpLowerName[16:18]: OpGreaterOrEqual,
+	_OpName[18:19]:      OpContains,
+	_OpLowerName[18:19]: OpContains,
+	_OpName[19:21]:      OpNotContains,
+	_OpLowerName[19:21]: OpNotContains,
+	_OpName[21:23]:      OpIn,
+	_OpLowerName[21:23]: OpIn,
+	_OpName[23:29]:      OpNotIn,
+	_OpLowerName[23:29]: OpNotIn,
+	_OpName[29:31]:      OpIs,
+	_OpLowerName[29:31]: OpIs,
+	_OpName[31:37]:      OpIsNot,
+	_OpLowerName[31:37]: OpIsNot,
+	_OpName[37:40]:      OpWas,
+	_OpLowerName[37:40]: OpWas,
+}
+
+var _OpNames = []string{
+	_OpName[0:9],
+	_OpName[9:10],
+	_OpName[10:12],
+	_OpName[12:13],
+	_OpName[13:15],
+	_OpName[15:16],
+	_OpName[16:18],
+	_OpName[18:19],
+	_OpName[19:21],
+	_OpName[21:23],
+	_OpName[23:29],
+	_OpName[29:31],
+	_OpName[31:37],
+	_OpName[37:40],
+}
+
+// OpString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func OpString(s string) (Op, error) {
+	if val, ok := _OpNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _OpNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Op values", s)
+}
+
+// OpValues returns all values of the enum
+func OpValues() []Op {
+	return _OpValues
+}
+
+// OpStrings returns a slice of all String values of the enum
+func OpStrings() []string {
+	strs := make([]string, len(_OpNames))
+	copy(strs, _OpNames)
+	return strs
+}
+
+// IsAOp returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Op) IsAOp() bool {
+	for _, v := range _OpValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+const endpointStatus = "rest/api/2/status"
+
+// Status category keys, as jira reports them.  Every status,
+// whatever its name, is in one of these categories.
+const (
+	StatusCategoryNew        = "new"
+	StatusCategoryInProgress = "indeterminate"
+	StatusCategoryDone       = "done"
+)
+
+type StatusCategory struct {
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// StatusInfo describes one status known to the jira instance.
+type StatusInfo struct {
+	Id             string         `json:"id,omitempty"`
+	Name           string         `json:"name,omitempty"`
+	StatusCategory StatusCategory `json:"statusCategory,omitempty"`
+}
+
+// Issue type hierarchy levels, as jira cloud reports them.  Jira server
+// doesn't report levels, so there they're inferred from the type.
+const (
+	TypeLevelSubtask  = -1
+	TypeLevelStandard = 0
+	TypeLevelEpic     = 1
+)
+
+// Catalog holds the statuses and issue types of the jira instance, loaded
+// at runtime rather than assumed.  Names are matched without regard to
+// case.  A nil Catalog falls back to the well-known names in IssueStatus
+// and IssueType.
+type Catalog struct {
+	statuses map[string]StatusInfo
+	types    map[string]IssueTypeFields
+}
+
+// GetCatalog loads the catalog on first use.
+func (jb *JiraBoss) GetCatalog() (*Catalog, error) {
+	if jb.catalog != nil {
+		return jb.catalog, nil
+	}
+	body, err := jb.punchItChewie(http.MethodGet, nil, endpointStatus)
+	if err != nil {
+		return nil, err
+	}
+	var statuses []StatusInfo
+	if err = json.Unmarshal(body, &statuses); err != nil {
+		return nil, fmt.Errorf("trouble unmarshaling statuses; %w", err)
+	}
+	types, err := jb.DoOneIssueTypeRequest()
+	if err != nil {
+		return nil, err
+	}
+	c := &Catalog{
+		statuses: make(map[string]StatusInfo),
+		types:    make(map[string]IssueTypeFields),
+	}
+	for _, s := range statuses {
+		c.statuses[strings.ToLower(s.Name)] = s
+	}
+	for _, t := range types {
+		c.types[strings.ToLower(t.Name)] = t
+	}
+	jb.catalog = c
+	return c, nil
+}
+
+// catalogOrNil returns the catalog, or nil, meaning the well-known names,
+// if it can't be loaded.  The trouble is reported once.
+func (jb *JiraBoss) catalogOrNil() *Catalog {
+	c, err := jb.GetCatalog()
+	if err != nil && !jb.catalogWarned {
+		utils.DoErrF("trouble loading the catalog, using well-known names; %s\n", err)
+		jb.catalogWarned = true
+	}
+	return c
+}
+
+// IsEpic is true if the issue's type is at the epic level.
+// Projects define their own issue types, so this asks the catalog
+// rather than use Type, which knows only the common ones.
+func (jb *JiraBoss) IsEpic(ri *ResponseIssue) bool {
+	return jb.catalogOrNil().IsEpic(ri.TypeRaw())
+}
+
+// IsOkayUnderEpic is true for any issue that isn't itself an epic
+// or above one, whatever the project calls its other types.
+func (jb *JiraBoss) IsOkayUnderEpic(ri *ResponseIssue) bool {
+	return jb.catalogOrNil().IsOkayUnderEpic(ri.TypeRaw())
+}
+
+// Status returns the status with the given name.
+func (c *Catalog) Status(name string) (StatusInfo, bool) {
+	if c == nil {
+		s, err := IssueStatusString(name)
+		if err != nil {
+			return StatusInfo{}, false
+		}
+		return StatusInfo{
+			Name:           s.String(),
+			StatusCategory: StatusCategory{Key: knownStatusCategory(s)},
+		}, true
+	}
+	s, ok := c.statuses[strings.ToLower(name)]
+	return s, ok
+}
+
+// StatusNames returns the names of all statuses, sorted.
+func (c *Catalog) StatusNames() []string {
+	var result []string
+	if c == nil {
+		for _, s := range IssueStatusValues()[1:] {
+			result = append(result, s.String())
+		}
+	} else {
+		for _, s := range c.statuses {
+			result = append(result, s.Name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Category returns the category key of the named status,
+// or the empty string if the status isn't known.
+func (c *Catalog) Category(status string) string {
+	s, _ := c.Status(status)
+	return s.StatusCategory.Key
+}
+
+// IsDone is true if the named status is in the done category.
+func (c *Catalog) IsDone(status string) bool {
+	return c.Category(status) == StatusCategoryDone
+}
+
+// IsInProgress is true if the named status is in the in progress category.
+func (c *Catalog) IsInProgress(status string) bool {
+	return c.Category(status) == StatusCategoryInProgress
+}
+
+// Type returns the issue type with the given name.
+func (c *Catalog) Type(name string) (IssueTypeFields, bool) {
+	if c == nil {
+		t, err := IssueTypeString(name)
+		if err != nil {
+			return IssueTypeFields{}, false
+		}
+		return IssueTypeFields{
+			Name:    t.String(),
+			Subtask: t == IssueTypeSubTask,
+		}, true
+	}
+	t, ok := c.types[strings.ToLower(name)]
+	return t, ok
+}
+
+// TypeLevel returns the hierarchy level of the named issue type.
+// Without a level from jira, sub-tasks are below standard types,
+// and epics and initiatives are known by name.
+func (c *Catalog) TypeLevel(name string) int {
+	t, ok := c.Type(name)
+	switch {
+	case ok && t.HierarchyLevel != nil:
+		return *t.HierarchyLevel
+	case ok && t.Subtask:
+		return TypeLevelSubtask
+	}
+	switch {
+	case strings.EqualFold(name, IssueTypeEpic.String()):
+		return TypeLevelEpic
+	case strings.EqualFold(name, IssueTypeInitiative.String()):
+		return TypeLevelEpic + 1
+	default:
+		return TypeLevelStandard
+	}
+}
+
+// IsEpic is true if the named issue type is at the epic level.
+func (c *Catalog) IsEpic(typeName string) bool {
+	return c.TypeLevel(typeName) == TypeLevelEpic
+}
+
+// IsOkayUnderEpic is true if the named issue type is below the epic level.
+func (c *Catalog) IsOkayUnderEpic(typeName string) bool {
+	return typeName != "" && c.TypeLevel(typeName) < TypeLevelEpic
+}
+
+// knownStatusCategory returns the category of the well-known statuses,
+// for use when jira hasn't said.
+func knownStatusCategory(s IssueStatus) string {
+	switch s {
+	case IssueStatusDone, IssueStatusClosed, IssueStatusClosedWoAction:
+		return StatusCategoryDone
+	case IssueStatusInProgress, IssueStatusInValidation, IssueStatusValidation,
+		IssueStatusReleaseCandidate, IssueStatusReadyForReview:
+		return StatusCategoryInProgress
+	case IssueStatusUnknown:
+		return ""
+	default:
+		return StatusCategoryNew
+	}
+}
@@ -0,0 +1,184 @@
+package myj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCatalog has a custom status in each category, and custom types,
+// one of them at the epic level.
+func testCatalog() *Catalog {
+	level := func(n int) *int { return &n }
+	status := func(name, category string) StatusInfo {
+		return StatusInfo{
+			Name: name, StatusCategory: StatusCategory{Key: category}}
+	}
+	return &Catalog{
+		statuses: map[string]StatusInfo{
+			"to do":       status("To Do", StatusCategoryNew),
+			"blocked":     status("Blocked", StatusCategoryNew),
+			"code review": status("Code Review", StatusCategoryInProgress),
+			"shipped":     status("Shipped", StatusCategoryDone),
+		},
+		types: map[string]IssueTypeFields{
+			"feature": {Name: "Feature", HierarchyLevel: level(TypeLevelEpic)},
+			"story":   {Name: "Story", HierarchyLevel: level(TypeLevelStandard)},
+			"chore":   {Name: "Chore"},
+			"step":    {Name: "Step", Subtask: true},
+		},
+	}
+}
+
+func TestCatalogStatus(t *testing.T) {
+	type testCase struct {
+		catalog      *Catalog
+		status       string
+		expectOk     bool
+		expectName   string
+		expectCat    string
+		expectIsDone bool
+	}
+	tests := map[string]testCase{
+		"custom in progress": {
+			catalog:    testCatalog(),
+			status:     "Code Review",
+			expectOk:   true,
+			expectName: "Code Review",
+			expectCat:  StatusCategoryInProgress,
+		},
+		"custom new, any case": {
+			catalog:    testCatalog(),
+			status:     "bLoCkEd",
+			expectOk:   true,
+			expectName: "Blocked",
+			expectCat:  StatusCategoryNew,
+		},
+		"custom done": {
+			catalog:      testCatalog(),
+			status:       "Shipped",
+			expectOk:     true,
+			expectName:   "Shipped",
+			expectCat:    StatusCategoryDone,
+			expectIsDone: true,
+		},
+		"well-known name not in catalog": {
+			catalog: testCatalog(),
+			status:  IssueStatusDone.String(),
+		},
+		"nil catalog knows done": {
+			status:       IssueStatusClosed.String(),
+			expectOk:     true,
+			expectName:   IssueStatusClosed.String(),
+			expectCat:    StatusCategoryDone,
+			expectIsDone: true,
+		},
+		"nil catalog knows in progress": {
+			status:     IssueStatusInProgress.String(),
+			expectOk:   true,
+			expectName: IssueStatusInProgress.String(),
+			expectCat:  StatusCategoryInProgress,
+		},
+		"nil catalog doesn't know custom": {
+			status: "Code Review",
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			s, ok := tc.catalog.Status(tc.status)
+			assert.Equal(t, tc.expectOk, ok)
+			assert.Equal(t, tc.expectName, s.Name)
+			assert.Equal(t, tc.expectCat, tc.catalog.Category(tc.status))
+			assert.Equal(t, tc.expectIsDone, tc.catalog.IsDone(tc.status))
+		})
+	}
+}
+
+func TestCatalogType(t *testing.T) {
+	type testCase struct {
+		catalog     *Catalog
+		typeName    string
+		expectLevel int
+		expectEpic  bool
+		expectUnder bool
+	}
+	tests := map[string]testCase{
+		"custom epic level": {
+			catalog:     testCatalog(),
+			typeName:    "Feature",
+			expectLevel: TypeLevelEpic,
+			expectEpic:  true,
+		},
+		"custom standard": {
+			catalog:     testCatalog(),
+			typeName:    "story",
+			expectLevel: TypeLevelStandard,
+			expectUnder: true,
+		},
+		"custom without level": {
+			catalog:     testCatalog(),
+			typeName:    "Chore",
+			expectLevel: TypeLevelStandard,
+			expectUnder: true,
+		},
+		"custom subtask": {
+			catalog:     testCatalog(),
+			typeName:    "Step",
+			expectLevel: TypeLevelSubtask,
+			expectUnder: true,
+		},
+		"no type": {
+			catalog: testCatalog(),
+		},
+		"nil catalog knows epic": {
+			typeName:    IssueTypeEpic.String(),
+			expectLevel: TypeLevelEpic,
+			expectEpic:  true,
+		},
+		"nil catalog knows initiative": {
+			typeName:    IssueTypeInitiative.String(),
+			expectLevel: TypeLevelEpic + 1,
+		},
+		"nil catalog knows subtask": {
+			typeName:    IssueTypeSubTask.String(),
+			expectLevel: TypeLevelSubtask,
+			expectUnder: true,
+		},
+		"nil catalog doesn't know custom": {
+			typeName:    "Feature",
+			expectLevel: TypeLevelStandard,
+			expectUnder: true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.expectLevel, tc.catalog.TypeLevel(tc.typeName))
+			assert.Equal(t, tc.expectEpic, tc.catalog.IsEpic(tc.typeName))
+			assert.Equal(t, tc.expectUnder, tc.catalog.IsOkayUnderEpic(tc.typeName))
+		})
+	}
+}
+
+func TestStatusColor(t *testing.T) {
+	issue := func(status, category string) *ResponseIssue {
+		ri := &ResponseIssue{}
+		ri.Fields.Status.Name = status
+		ri.Fields.Status.StatusCategory.Key = category
+		return ri
+	}
+	tests := map[string]struct {
+		issue  *ResponseIssue
+		expect string
+	}{
+		"custom done":        {issue("Shipped", StatusCategoryDone), "lightgreen"},
+		"custom in progress": {issue("Code Review", StatusCategoryInProgress), "pink"},
+		"custom new":         {issue("Blocked", StatusCategoryNew), "white"},
+		"well-known done":    {issue(IssueStatusClosedWoAction.String(), ""), "lightgreen"},
+		"well-known new":     {issue(IssueStatusInQueue.String(), ""), "white"},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.expect, string(StatusColor(tc.issue, ColorKindDot)))
+		})
+	}
+}
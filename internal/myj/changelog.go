@@ -0,0 +1,151 @@
+package myj
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// Names of fields, as they appear in changelog items,
+// other than the custom fields named elsewhere.
+const (
+	ChangeFieldStatus = "status"
+	ChangeFieldLink   = "Link"
+)
+
+// Changelog holds an issue's history, returned when the issue
+// is requested with expand=changelog.
+type Changelog struct {
+	StartAt    int       `json:"startAt,omitempty"`
+	MaxResults int       `json:"maxResults,omitempty"`
+	Total      int       `json:"total,omitempty"`
+	Histories  []History `json:"histories,omitempty"`
+}
+
+// History is one edit of an issue, possibly changing several fields.
+type History struct {
+	Id      string        `json:"id,omitempty"`
+	Author  humanUser     `json:"author,omitempty"`
+	Created string        `json:"created,omitempty"`
+	Items   []HistoryItem `json:"items,omitempty"`
+}
+
+// HistoryItem is the change to one field in a History.
+type HistoryItem struct {
+	Field      string `json:"field,omitempty"`
+	FieldType  string `json:"fieldtype,omitempty"`
+	From       string `json:"from,omitempty"`
+	FromString string `json:"fromString,omitempty"`
+	To         string `json:"to,omitempty"`
+	ToString   string `json:"toString,omitempty"`
+}
+
+// FieldChange is one change to one field of an issue.
+type FieldChange struct {
+	Issue  MyKey
+	Field  string
+	When   utils.Date
+	Author string
+	// From and To are the values as displayed.
+	From string
+	To   string
+	// RawFrom and RawTo are the values as stored, e.g. ids
+	// rather than names, or yyyy-mm-dd rather than "3/Mar/20".
+	RawFrom string
+	RawTo   string
+}
+
+// FieldChanges returns, oldest first, the changes recorded in the issue's
+// changelog to the given fields (all fields if none are given).
+// The issue must have been fetched with its changelog.
+func (ri *ResponseIssue) FieldChanges(fields ...string) (result []FieldChange) {
+	if ri.Changelog == nil {
+		return nil
+	}
+	want := func(f string) bool {
+		if len(fields) == 0 {
+			return true
+		}
+		for _, x := range fields {
+			if strings.EqualFold(x, f) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, h := range ri.Changelog.Histories {
+		when, err := utils.ParseTimestamp(h.Created)
+		if err != nil {
+			continue
+		}
+		author := h.Author.DisplayName
+		if author == "" {
+			author = h.Author.Name
+		}
+		for _, item := range h.Items {
+			if !want(item.Field) {
+				continue
+			}
+			result = append(result, FieldChange{
+				Issue:   ri.MyKey,
+				Field:   item.Field,
+				When:    when,
+				Author:  author,
+				From:    firstNonEmpty(item.FromString, item.From),
+				To:      firstNonEmpty(item.ToString, item.To),
+				RawFrom: item.From,
+				RawTo:   item.To,
+			})
+		}
+	}
+	// Jira returns histories oldest first, but don't count on it.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].When.Before(result[j].When)
+	})
+	return
+}
+
+func firstNonEmpty(a, b string) string {
+	if a != "" {
+		return a
+	}
+	return b
+}
+
+// DateChange is a change to an issue's start or end date.
+type DateChange struct {
+	FieldChange
+	// Delta is how many days the date moved (positive means later),
+	// or zero if either the old or new date is missing.
+	Delta int
+}
+
+// DateChanges returns, oldest first, the changes to the issue's
+// start and end dates.
+func (ri *ResponseIssue) DateChanges() (result []DateChange) {
+	for _, c := range ri.FieldChanges(
+		CustomFieldStartDate, CustomFieldTargetCompletionDate) {
+		dc := DateChange{FieldChange: c}
+		// The displayed values, like "3/Mar/20", don't parse.
+		from, err1 := utils.ParseDate(c.RawFrom)
+		to, err2 := utils.ParseDate(c.RawTo)
+		if c.RawFrom != "" && c.RawTo != "" && err1 == nil && err2 == nil {
+			dc.Delta = from.DayCount(to) - 1
+		}
+		result = append(result, dc)
+	}
+	return
+}
+
+// Slips returns the number of times the issue's end date was pushed
+// later, and the total number of days it was pushed.
+func (ri *ResponseIssue) Slips() (count, days int) {
+	for _, c := range ri.DateChanges() {
+		if c.Field == CustomFieldTargetCompletionDate && c.Delta > 0 {
+			count++
+			days += c.Delta
+		}
+	}
+	return
+}
@@ -0,0 +1,102 @@
+package myj
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// changelogIssue makes an issue with one history per item, as jira
+// returns them; date fields have ISO values and display strings.
+func changelogIssue(t *testing.T, items ...string) *ResponseIssue {
+	t.Helper()
+	var histories []string
+	for _, item := range items {
+		histories = append(histories, `{
+  "id": "1", "author": {"name": "jdoe"},
+  "created": "2025-03-01T10:00:00.000+0000",
+  "items": [`+item+`]}`)
+	}
+	data := `{"key": "BUDS-1", "changelog": {"histories": [` +
+		strings.Join(histories, ",") + `]}}`
+	var ri ResponseIssue
+	assert.NoError(t, json.Unmarshal([]byte(data), &ri))
+	return &ri
+}
+
+func TestDateChanges(t *testing.T) {
+	type testCase struct {
+		items       []string
+		deltas      []int
+		slips, days int
+	}
+	tests := map[string]testCase{
+		"pushedLater": {
+			items: []string{
+				endItem("2025-03-10", "10/Mar/25", "2025-03-17", "17/Mar/25"),
+			},
+			deltas: []int{7},
+			slips:  1,
+			days:   7,
+		},
+		"pulledIn": {
+			items: []string{
+				endItem("2025-03-17", "17/Mar/25", "2025-03-14", "14/Mar/25"),
+			},
+			deltas: []int{-3},
+		},
+		"firstSet": {
+			items: []string{
+				endItem("", "", "2025-03-17", "17/Mar/25"),
+			},
+			deltas: []int{0},
+		},
+		"startDateIgnoredBySlips": {
+			items: []string{
+				`{"field": "Start Date", "fieldtype": "custom",
+  "from": "2025-03-03", "fromString": "3/Mar/25",
+  "to": "2025-03-05", "toString": "5/Mar/25"}`,
+			},
+			deltas: []int{2},
+		},
+		"slippedTwice": {
+			items: []string{
+				endItem("2025-03-10", "10/Mar/25", "2025-03-17", "17/Mar/25"),
+				`{"field": "summary", "from": null, "fromString": "a", "to": null, "toString": "b"}`,
+				endItem("2025-03-17", "17/Mar/25", "2025-04-01", "1/Apr/25"),
+			},
+			deltas: []int{7, 15},
+			slips:  2,
+			days:   22,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ri := changelogIssue(t, tc.items...)
+			var deltas []int
+			for _, c := range ri.DateChanges() {
+				deltas = append(deltas, c.Delta)
+			}
+			assert.Equal(t, tc.deltas, deltas)
+			slips, days := ri.Slips()
+			assert.Equal(t, tc.slips, slips)
+			assert.Equal(t, tc.days, days)
+		})
+	}
+}
+
+// endItem is a change to the end date, as jira reports it.
+func endItem(from, fromString, to, toString string) string {
+	item := map[string]string{
+		"field":      CustomFieldTargetCompletionDate,
+		"fieldtype":  "custom",
+		"from":       from,
+		"fromString": fromString,
+		"to":         to,
+		"toString":   toString,
+	}
+	data, _ := json.Marshal(item)
+	return string(data)
+}
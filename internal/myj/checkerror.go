@@ -0,0 +1,33 @@
+package myj
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// CheckError is returned by CheckEpics and CheckIssues, and says which
+// issues had which problems, so a caller can point at them.
+type CheckError struct {
+	msg      string
+	Problems map[MyKey][]string
+}
+
+func (e *CheckError) Error() string {
+	return e.msg
+}
+
+// problems accumulates the problems found by a check.
+type problems map[MyKey][]string
+
+// add reports a problem with the given issue.
+func (p problems) add(k MyKey, f string, args ...any) {
+	msg := fmt.Sprintf(f, args...)
+	utils.DoErrF("%s\n", msg)
+	p[k] = append(p[k], strings.TrimSpace(msg))
+}
+
+func (p problems) asError(msg string) error {
+	return &CheckError{msg: msg, Problems: p}
+}
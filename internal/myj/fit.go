@@ -0,0 +1,87 @@
+package myj
+
+import (
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// storySpan returns the earliest start and latest end of the dated stories.
+func storySpan(stories IssueList) (start, end utils.Date, ok bool) {
+	for _, s := range stories {
+		sStart, sEnd := s.DateStart(), s.DateEnd()
+		if !sStart.IsDefined() || !sEnd.IsDefined() || sEnd.Before(sStart) {
+			continue
+		}
+		if !ok || sStart.Before(start) {
+			start = sStart
+		}
+		if !ok || sEnd.After(end) {
+			end = sEnd
+		}
+		ok = true
+	}
+	return
+}
+
+// FitEpics proposes new dates for the epics so that each covers the dates
+// of its stories.  Epics only grow, unless shrink is true, in which case
+// they exactly match the span of their stories.  Epics without dated
+// stories are left alone.  The result can be passed to WriteDates.
+func FitEpics(
+	epics map[MyKey]*ResponseIssue, stories map[MyKey]IssueList,
+	shrink bool) map[MyKey]*Node {
+	result := make(map[MyKey]*Node)
+	for k, epic := range epics {
+		if k.Num >= UnknownEpicBase {
+			continue
+		}
+		start, end, ok := storySpan(stories[k])
+		if !ok {
+			continue
+		}
+		n := MakeNode(epic)
+		if shrink || !n.dateStart.IsDefined() || start.Before(n.dateStart) {
+			n.dateStart = start
+		}
+		if shrink || !n.dateEnd.IsDefined() || end.After(n.dateEnd) {
+			n.dateEnd = end
+		}
+		result[k] = n
+	}
+	return result
+}
+
+// ClampStories proposes new dates for the stories so that each falls
+// inside its epic's dates; the reverse of FitEpics.  Undated stories,
+// and the stories of undated epics, are left alone.
+func ClampStories(
+	epics map[MyKey]*ResponseIssue, stories map[MyKey]IssueList) map[MyKey]*Node {
+	clamp := func(d, lo, hi utils.Date) utils.Date {
+		if d.Before(lo) {
+			return lo
+		}
+		if d.After(hi) {
+			return hi
+		}
+		return d
+	}
+	result := make(map[MyKey]*Node)
+	for k, epic := range epics {
+		if k.Num >= UnknownEpicBase {
+			continue
+		}
+		lo, hi := epic.DateStart(), epic.DateEnd()
+		if !lo.IsDefined() || !hi.IsDefined() || hi.Before(lo) {
+			continue
+		}
+		for _, s := range stories[k] {
+			n := MakeNode(s)
+			if !n.dateStart.IsDefined() || !n.dateEnd.IsDefined() {
+				continue
+			}
+			n.dateStart = clamp(n.dateStart, lo, hi)
+			n.dateEnd = clamp(n.dateEnd, lo, hi)
+			result[s.MyKey] = n
+		}
+	}
+	return result
+}
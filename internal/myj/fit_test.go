@@ -0,0 +1,140 @@
+package myj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fitIssue makes an issue with the given dates, which may be empty.
+func fitIssue(num int, start, end string) *ResponseIssue {
+	ri := &ResponseIssue{MyKey: MyKey{Proj: "FIT", Num: num}}
+	ri.Fields.CustomStartDate = start
+	ri.Fields.CustomTargetCompletionDate = end
+	return ri
+}
+
+// span is a proposed start and end date.
+type span struct{ start, end string }
+
+// proposed returns the dates in the nodes, by issue number.
+func proposed(nodes map[MyKey]*Node) map[int]span {
+	result := make(map[int]span)
+	for k, n := range nodes {
+		result[k.Num] = span{n.dateStart.JiraFormat(), n.dateEnd.JiraFormat()}
+	}
+	return result
+}
+
+type fitTestCase struct {
+	epic    *ResponseIssue
+	stories IssueList
+	shrink  bool
+	expect  map[int]span
+}
+
+func TestFitEpics(t *testing.T) {
+	tests := map[string]fitTestCase{
+		"expand to cover stories": {
+			epic: fitIssue(1, "2026-03-09", "2026-03-13"),
+			stories: IssueList{
+				fitIssue(2, "2026-03-02", "2026-03-06"),
+				fitIssue(3, "2026-03-16", "2026-03-20"),
+			},
+			expect: map[int]span{1: {"2026-03-02", "2026-03-20"}},
+		},
+		"expand only the end": {
+			epic:    fitIssue(1, "2026-03-02", "2026-03-13"),
+			stories: IssueList{fitIssue(2, "2026-03-09", "2026-03-20")},
+			expect:  map[int]span{1: {"2026-03-02", "2026-03-20"}},
+		},
+		"no shrink": {
+			epic:    fitIssue(1, "2026-03-02", "2026-03-20"),
+			stories: IssueList{fitIssue(2, "2026-03-09", "2026-03-13")},
+			expect:  map[int]span{1: {"2026-03-02", "2026-03-20"}},
+		},
+		"shrink": {
+			epic:    fitIssue(1, "2026-03-02", "2026-03-20"),
+			stories: IssueList{fitIssue(2, "2026-03-09", "2026-03-13")},
+			shrink:  true,
+			expect:  map[int]span{1: {"2026-03-09", "2026-03-13"}},
+		},
+		"undated epic takes the stories' span": {
+			epic:    fitIssue(1, "", ""),
+			stories: IssueList{fitIssue(2, "2026-03-09", "2026-03-13")},
+			expect:  map[int]span{1: {"2026-03-09", "2026-03-13"}},
+		},
+		"stories with no dates or bad dates are ignored": {
+			epic: fitIssue(1, "2026-03-09", "2026-03-13"),
+			stories: IssueList{
+				fitIssue(2, "", ""),
+				fitIssue(3, "2026-03-02", ""),
+				fitIssue(4, "2026-03-20", "2026-03-02"),
+				fitIssue(5, "2026-03-10", "2026-03-16"),
+			},
+			expect: map[int]span{1: {"2026-03-09", "2026-03-16"}},
+		},
+		"no dated stories": {
+			epic:    fitIssue(1, "2026-03-09", "2026-03-13"),
+			stories: IssueList{fitIssue(2, "", "")},
+			expect:  map[int]span{},
+		},
+		"placeholder epic": {
+			epic:    makePlaceHolderEpic(UnknownEpicBase, "FIT"),
+			stories: IssueList{fitIssue(2, "2026-03-09", "2026-03-13")},
+			expect:  map[int]span{},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			epics := map[MyKey]*ResponseIssue{tc.epic.MyKey: tc.epic}
+			stories := map[MyKey]IssueList{tc.epic.MyKey: tc.stories}
+			assert.Equal(t, tc.expect, proposed(FitEpics(epics, stories, tc.shrink)))
+		})
+	}
+}
+
+func TestClampStories(t *testing.T) {
+	tests := map[string]fitTestCase{
+		"clamp both ends": {
+			epic:    fitIssue(1, "2026-03-09", "2026-03-13"),
+			stories: IssueList{fitIssue(2, "2026-03-02", "2026-03-20")},
+			expect:  map[int]span{2: {"2026-03-09", "2026-03-13"}},
+		},
+		"clamp a story entirely outside": {
+			epic:    fitIssue(1, "2026-03-09", "2026-03-13"),
+			stories: IssueList{fitIssue(2, "2026-03-16", "2026-03-20")},
+			expect:  map[int]span{2: {"2026-03-13", "2026-03-13"}},
+		},
+		"inside stays put": {
+			epic:    fitIssue(1, "2026-03-02", "2026-03-20"),
+			stories: IssueList{fitIssue(2, "2026-03-09", "2026-03-13")},
+			expect:  map[int]span{2: {"2026-03-09", "2026-03-13"}},
+		},
+		"stories with no dates": {
+			epic: fitIssue(1, "2026-03-09", "2026-03-13"),
+			stories: IssueList{
+				fitIssue(2, "", ""),
+				fitIssue(3, "2026-03-02", ""),
+			},
+			expect: map[int]span{},
+		},
+		"undated epic": {
+			epic:    fitIssue(1, "", ""),
+			stories: IssueList{fitIssue(2, "2026-03-02", "2026-03-20")},
+			expect:  map[int]span{},
+		},
+		"placeholder epic": {
+			epic:    makePlaceHolderEpic(UnknownEpicBase, "FIT"),
+			stories: IssueList{fitIssue(2, "2026-03-02", "2026-03-20")},
+			expect:  map[int]span{},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			epics := map[MyKey]*ResponseIssue{tc.epic.MyKey: tc.epic}
+			stories := map[MyKey]IssueList{tc.epic.MyKey: tc.stories}
+			assert.Equal(t, tc.expect, proposed(ClampStories(epics, stories)))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package myj
+
+import (
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// Flow holds flow metrics of a resolved issue, in days, computed from
+// the status transitions in its changelog.
+type Flow struct {
+	Issue *ResponseIssue
+	// LeadDays runs from creation to done.
+	LeadDays int
+	// CycleDays runs from first going in progress to done,
+	// and is negative if the issue never went in progress.
+	CycleDays int
+	// DaysIn holds the days spent in each status before done,
+	// keyed by status name.
+	DaysIn map[string]int
+}
+
+// Flow returns the issue's flow metrics.  The issue must have been
+// fetched with its changelog.  Days are whole days, so something
+// done the day it's started takes zero days.  The catalog says which
+// statuses are done or in progress; it may be nil.
+func (ri *ResponseIssue) Flow(catalog *Catalog) Flow {
+	created := ri.DateCreated()
+	result := Flow{
+		Issue:     ri,
+		CycleDays: -1,
+		DaysIn:    make(map[string]int),
+	}
+	changes := ri.FieldChanges(ChangeFieldStatus)
+	// Use the last arrival in a done state, in case the issue was reopened.
+	done := ri.DateResolved()
+	for _, c := range changes {
+		if c.To != "" && catalog.IsDone(c.To) {
+			done = c.When
+		}
+	}
+	if !done.IsDefined() {
+		done = utils.Today()
+	}
+	result.LeadDays = days(created, done)
+
+	status := ri.StatusRaw()
+	if len(changes) > 0 {
+		status = changes[0].From
+	}
+	since := created
+	for _, c := range changes {
+		if c.When.After(done) {
+			break
+		}
+		result.DaysIn[status] += days(since, c.When)
+		status = c.To
+		since = c.When
+		if catalog.IsInProgress(status) && result.CycleDays < 0 {
+			result.CycleDays = days(c.When, done)
+		}
+	}
+	if !catalog.IsDone(status) {
+		result.DaysIn[status] += days(since, done)
+	}
+	return result
+}
+
+// days returns the whole days from one date to a later one.
+func days(from, to utils.Date) int {
+	return max(0, from.DayCount(to)-1)
+}
@@ -0,0 +1,123 @@
+package myj
+
+import (
+	"testing"
+
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// move is a status change some days after the issue was created.
+type move struct {
+	day      int
+	from, to string
+}
+
+// flowIssue makes an issue created some days ago, with the given
+// status changes, resolved on the day of the last move to a done
+// status, if any.
+func flowIssue(ago int, moves ...move) *ResponseIssue {
+	created := utils.Today().AddDays(-ago)
+	stamp := func(d utils.Date) string {
+		return d.JiraFormat() + "T10:00:00.000+0000"
+	}
+	ri := &ResponseIssue{Changelog: &Changelog{}}
+	ri.Fields.Created = stamp(created)
+	ri.Fields.Status.Name = IssueStatusBacklog.String()
+	for _, m := range moves {
+		when := created.AddDays(m.day)
+		ri.Changelog.Histories = append(ri.Changelog.Histories, History{
+			Created: stamp(when),
+			Items: []HistoryItem{{
+				Field: ChangeFieldStatus, FromString: m.from, ToString: m.to}},
+		})
+		ri.Fields.Status.Name = m.to
+		if m.to == IssueStatusDone.String() {
+			ri.Fields.ResolutionDate = stamp(when)
+		}
+	}
+	return ri
+}
+
+func TestFlow(t *testing.T) {
+	const (
+		backlog    = "Backlog"
+		inProgress = "In Progress"
+		done       = "Done"
+	)
+	type testCase struct {
+		issue  *ResponseIssue
+		lead   int
+		cycle  int
+		daysIn map[string]int
+	}
+	tests := map[string]testCase{
+		"straight through": {
+			issue: flowIssue(30,
+				move{2, backlog, inProgress},
+				move{7, inProgress, done}),
+			lead:   7,
+			cycle:  5,
+			daysIn: map[string]int{backlog: 2, inProgress: 5},
+		},
+		"reopened": {
+			issue: flowIssue(30,
+				move{1, backlog, inProgress},
+				move{3, inProgress, done},
+				move{8, done, inProgress},
+				move{10, inProgress, done}),
+			// Done is the last arrival in done.
+			lead: 10,
+			// Cycle starts at the first arrival in progress.
+			cycle:  9,
+			daysIn: map[string]int{backlog: 1, inProgress: 4, done: 5},
+		},
+		"never started": {
+			issue: flowIssue(30,
+				move{4, backlog, done}),
+			lead:   4,
+			cycle:  -1,
+			daysIn: map[string]int{backlog: 4},
+		},
+		"not done": {
+			issue: flowIssue(10,
+				move{3, backlog, inProgress}),
+			// Counted up to today.
+			lead:   10,
+			cycle:  7,
+			daysIn: map[string]int{backlog: 3, inProgress: 7},
+		},
+		"untouched": {
+			issue:  flowIssue(6),
+			lead:   6,
+			cycle:  -1,
+			daysIn: map[string]int{backlog: 6},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := tc.issue.Flow(nil)
+			assert.Equal(t, tc.lead, f.LeadDays)
+			assert.Equal(t, tc.cycle, f.CycleDays)
+			assert.Equal(t, tc.daysIn, f.DaysIn)
+		})
+	}
+}
+
+func TestFlowWithCatalog(t *testing.T) {
+	catalog := &Catalog{statuses: map[string]StatusInfo{
+		"to do": {Name: "To Do",
+			StatusCategory: StatusCategory{Key: StatusCategoryNew}},
+		"code review": {Name: "Code Review",
+			StatusCategory: StatusCategory{Key: StatusCategoryInProgress}},
+		"shipped": {Name: "Shipped",
+			StatusCategory: StatusCategory{Key: StatusCategoryDone}},
+	}}
+	ri := flowIssue(30,
+		move{2, "To Do", "Code Review"},
+		move{5, "Code Review", "Shipped"})
+	f := ri.Flow(catalog)
+	assert.Equal(t, 5, f.LeadDays)
+	assert.Equal(t, 3, f.CycleDays)
+	assert.Equal(t, map[string]int{"To Do": 2, "Code Review": 3}, f.DaysIn)
+}
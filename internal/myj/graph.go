@@ -63,9 +63,7 @@ func MakeNode(epic *ResponseIssue) *Node {
 }
 
 func (n *Node) seemsDone() bool {
-	return n.issue.Status() == IssueStatusClosed ||
-		n.issue.Status() == IssueStatusDone ||
-		n.issue.Status() == IssueStatusClosedWoAction
+	return n.issue.SeemsDone()
 }
 
 func (n *Node) writeDiGraphNode(w io.Writer) {
@@ -73,7 +71,7 @@ func (n *Node) writeDiGraphNode(w io.Writer) {
 		w,
 		"  %q [label=\"%s\" style=filled fillcolor=%s];\n",
 		n.issue.MyKey, n.digraphLabel(),
-		StatusColor(n.issue.Status(), ColorKindDot))
+		StatusColor(n.issue, ColorKindDot))
 }
 
 func (n *Node) digraphLabel() string {
@@ -194,6 +192,40 @@ func (g *Graph) resetVisits() {
 	}
 }
 
+// Shift is a proposed change to an epic's dates.
+type Shift struct {
+	Issue *ResponseIssue
+	Start utils.Date
+	End   utils.Date
+}
+
+// ProjectEnd proposes a new end date for the given epic, pushes
+// its dependents later as needed, and returns the proposed dates of
+// the other epics whose end dates moved, in key order.
+// Proposals from earlier calls are discarded.
+func (g *Graph) ProjectEnd(k MyKey, end utils.Date) ([]Shift, error) {
+	n, ok := g.nodes[k]
+	if !ok {
+		return nil, fmt.Errorf("%s is not in the graph", k)
+	}
+	for _, node := range g.nodes {
+		node.dateStart = node.issue.DateStart()
+		node.dateEnd = node.issue.DateEnd()
+	}
+	g.resetVisits()
+	n.dateEnd = end
+	n.MaybeShiftDependentsLater()
+	var result []Shift
+	for _, key := range g.sortedKeys() {
+		node := g.nodes[key]
+		if key != k && !node.dateEnd.Equal(node.issue.DateEnd()) {
+			result = append(result, Shift{
+				Issue: node.issue, Start: node.dateStart, End: node.dateEnd})
+		}
+	}
+	return result, nil
+}
+
 // MaybeShiftDependentsLater might push dependent ("child") epics out in time
 // to start after their dependencies ("parents") end.
 func (g *Graph) MaybeShiftDependentsLater() {
@@ -0,0 +1,147 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// This file holds ways to write a Graph other than WriteDigraph.
+// All of them write nodes and edges in a stable order so that
+// the output can be checked in and diffed.
+
+// sortedKeys returns the graph's node keys sorted by project and number.
+func (g *Graph) sortedKeys() []MyKey {
+	keys := make([]MyKey, 0, len(g.nodes))
+	for k := range g.nodes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Less(keys[j])
+	})
+	return keys
+}
+
+// sortedEdges returns the graph's edges sorted by parent, then child.
+func (g *Graph) sortedEdges() []Edge {
+	edges := make([]Edge, 0, len(g.edges))
+	for e := range g.edges {
+		edges = append(edges, e)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].parent == edges[j].parent {
+			return edges[i].child.Less(edges[j].child)
+		}
+		return edges[i].parent.Less(edges[j].parent)
+	})
+	return edges
+}
+
+// WriteMermaid writes the graph as a mermaid flowchart, suitable for
+// embedding in markdown in a ```mermaid block.
+// See https://mermaid.js.org/syntax/flowchart.html
+func (g *Graph) WriteMermaid(w io.Writer, flip bool) {
+	_, _ = fmt.Fprintf(w, "flowchart %s\n",
+		func() string {
+			if flip {
+				return "BT"
+			}
+			return "TB"
+		}())
+	for _, k := range g.sortedKeys() {
+		n := g.nodes[k]
+		label := strings.ReplaceAll(n.digraphLabel(), `"`, "#quot;")
+		label = strings.ReplaceAll(label, "\n", "<br/>")
+		_, _ = fmt.Fprintf(w, "  %s[\"%s\"]\n", k.Id(), label)
+		_, _ = fmt.Fprintf(w, "  style %s fill:%s,color:black\n",
+			k.Id(), StatusColor(n.issue, ColorKindDot))
+	}
+	for _, e := range g.sortedEdges() {
+		_, _ = fmt.Fprintf(w, "  %s --> %s\n", e.parent.Id(), e.child.Id())
+	}
+}
+
+// WritePlantUml writes the graph as a plantuml diagram.
+// See https://plantuml.com/deployment-diagram
+func (g *Graph) WritePlantUml(w io.Writer, flip bool) {
+	_, _ = fmt.Fprintln(w, "@startuml")
+	for _, k := range g.sortedKeys() {
+		n := g.nodes[k]
+		// plantuml has no escape for a double quote in a label.
+		label := strings.ReplaceAll(n.digraphLabel(), `"`, "'")
+		label = strings.ReplaceAll(label, "\n", `\n`)
+		_, _ = fmt.Fprintf(w, "rectangle \"%s\" as %s #%s\n",
+			label, k.Id(), StatusColor(n.issue, ColorKindDot))
+	}
+	arrow := "-->"
+	if flip {
+		arrow = "-up->"
+	}
+	for _, e := range g.sortedEdges() {
+		_, _ = fmt.Fprintf(w, "%s %s %s\n",
+			e.parent.Id(), arrow, e.child.Id())
+	}
+	_, _ = fmt.Fprintln(w, "@enduml")
+}
+
+type jsonGraph struct {
+	Nodes []jsonNode `json:"nodes"`
+	Edges []jsonEdge `json:"edges"`
+}
+
+type jsonNode struct {
+	Key      string   `json:"key"`
+	Summary  string   `json:"summary"`
+	Status   string   `json:"status"`
+	Assignee string   `json:"assignee,omitempty"`
+	Labels   []string `json:"labels,omitempty"`
+	Start    string   `json:"start,omitempty"`
+	End      string   `json:"end,omitempty"`
+	Color    string   `json:"color"`
+	Label    string   `json:"label"`
+}
+
+// jsonEdge says that "from" must be done before "to" can start.
+type jsonEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// WriteJson writes the graph as JSON, for use by other tools.
+func (g *Graph) WriteJson(w io.Writer) error {
+	var result jsonGraph
+	for _, k := range g.sortedKeys() {
+		n := g.nodes[k]
+		result.Nodes = append(result.Nodes, jsonNode{
+			Key:      k.String(),
+			Summary:  n.issue.MySummary(),
+			Status:   n.issue.StatusRaw(),
+			Assignee: n.issue.AssigneeLdap(),
+			Labels:   n.issue.Fields.Labels,
+			Start:    jsonDate(n.dateStart),
+			End:      jsonDate(n.dateEnd),
+			Color:    string(StatusColor(n.issue, ColorKindDot)),
+			Label:    n.digraphLabel(),
+		})
+	}
+	for _, e := range g.sortedEdges() {
+		result.Edges = append(result.Edges, jsonEdge{
+			From: e.parent.String(),
+			To:   e.child.String(),
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
+
+func jsonDate(d utils.Date) string {
+	if !d.IsDefined() {
+		return ""
+	}
+	return d.JiraFormat()
+}
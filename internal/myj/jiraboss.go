@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"os"
 	"strconv"
-	"strings"
 
 	"github.com/monopole/gojira/internal/utils"
 )
@@ -18,6 +17,15 @@ type MyJiraArgs struct {
 	Host    string
 	Project string
 	Token   string
+	// DependencyLinkTypes name the link types that make one issue
+	// depend on another, e.g. "Blocks".  The inward issue is the one
+	// depended on.
+	DependencyLinkTypes []string
+	// JournalPath is the file recording writes, so they can be undone.
+	// If empty, writes aren't recorded.
+	JournalPath string
+	// DryRun, if true, means writes are printed rather than made.
+	DryRun bool
 }
 
 type JiraBossIfc interface {
@@ -35,6 +43,14 @@ type JiraBoss struct {
 	htCl            *http.Client
 	args            *MyJiraArgs
 	placeholderEpic *ResponseIssue
+	// catalog is loaded on first use by GetCatalog.
+	catalog       *Catalog
+	catalogWarned bool
+	// session identifies this run's entries in the journal.
+	session    string
+	journalSeq int
+	// undoing is the id of the journal entry being undone, if any.
+	undoing string
 }
 
 func MakeJiraBoss(htCl *http.Client, args *MyJiraArgs) JiraBoss {
@@ -45,6 +61,19 @@ func MakeJiraBoss(htCl *http.Client, args *MyJiraArgs) JiraBoss {
 	}
 }
 
+// DependencyLinkTypes returns the link types that count as dependencies.
+func (jb *JiraBoss) DependencyLinkTypes() []string {
+	if len(jb.args.DependencyLinkTypes) == 0 {
+		return []string{LinkTypeBlocks}
+	}
+	return jb.args.DependencyLinkTypes
+}
+
+// DryRun is true if writes are printed rather than made.
+func (jb *JiraBoss) DryRun() bool {
+	return jb.args.DryRun
+}
+
 func (jb *JiraBoss) Project() string {
 	return jb.args.Project
 }
@@ -72,7 +101,7 @@ func (jb *JiraBoss) RenameIssue(n int, name string) error {
 			},
 		},
 	}
-	if issue.IsEpic() {
+	if jb.IsEpic(issue) {
 		// For epics, always make the "short" name match the summary
 		req.Fields.CustomEpicName = name
 	}
@@ -195,10 +224,6 @@ func (jb *JiraBoss) writeOneEpic(epic *ResponseIssue) (err error) {
 			},
 		},
 	}
-	if debug {
-		utils.DoErrF("Would write epic %+v\n", req)
-		return nil
-	}
 	_, err = jb.punchItChewie(
 		http.MethodPut, req,
 		endpointIssue+"/"+epic.MyKey.String())
@@ -221,7 +246,7 @@ func (jb *JiraBoss) WriteIssues(
 
 // writeOneIssue writes an issue to jira with the given epic link
 func (jb *JiraBoss) writeOneIssue(issue *ResponseIssue, epic MyKey) (err error) {
-	if issue.Fields.Summary == "" || issue.Status() == IssueStatusUnknown {
+	if issue.Fields.Summary == "" || issue.StatusRaw() == "" {
 		return fmt.Errorf("bad data in issue write")
 	}
 	type fieldsToWrite struct {
@@ -245,18 +270,12 @@ func (jb *JiraBoss) writeOneIssue(issue *ResponseIssue, epic MyKey) (err error)
 			},
 		},
 	}
-	if debug {
-		utils.DoErrF("Would write issue %+v\n", req)
-		return nil
-	}
 	_, err = jb.punchItChewie(
 		http.MethodPut, req,
 		endpointIssue+"/"+issue.MyKey.String())
 	return err
 }
 
-const debug = false
-
 // GetCustomFieldId recovers information about field names that one
 // needs to get what one wants from the API.
 func (jb *JiraBoss) GetCustomFieldId(name string) string {
@@ -281,8 +300,50 @@ const (
 )
 
 func (jb *JiraBoss) DoPagedSearch(
+	jql string) (result []ResponseIssue, err error) {
+	return jb.doPagedSearch(makeSearchRequest(jql))
+}
+
+// DoPagedSearchWithChangelog is DoPagedSearch, with each issue's
+// changelog included in the result.
+func (jb *JiraBoss) DoPagedSearchWithChangelog(
 	jql string) (result []ResponseIssue, err error) {
 	req := makeSearchRequest(jql)
+	req.Expand = append(req.Expand, "changelog")
+	if result, err = jb.doPagedSearch(req); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		if err = jb.completeChangelog(&result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// completeChangelog fetches the issue's whole changelog if a search
+// returned only part of it, and warns if that still isn't all of it.
+func (jb *JiraBoss) completeChangelog(ri *ResponseIssue) error {
+	c := ri.Changelog
+	if c == nil || len(c.Histories) >= c.Total {
+		return nil
+	}
+	full, err := jb.getIssueWithChangelog(ri.Key)
+	if err != nil {
+		return err
+	}
+	if full.Changelog != nil && len(full.Changelog.Histories) > len(c.Histories) {
+		ri.Changelog = full.Changelog
+	}
+	if n := len(ri.Changelog.Histories); n < ri.Changelog.Total {
+		utils.DoErrF("only have %d of the %d changes to %s\n",
+			n, ri.Changelog.Total, ri.Key)
+	}
+	return nil
+}
+
+func (jb *JiraBoss) doPagedSearch(
+	req RequestSearch) (result []ResponseIssue, err error) {
 	for {
 		var resp *ResponseSearch
 		resp, err = jb.doOneSearchRequest(req)
@@ -324,12 +385,14 @@ func (jb *JiraBoss) doOneSearchRequest(
 }
 
 type IssueTypeFields struct {
-	Description string `json:"description,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Id          string `json:"id,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Id             string `json:"id,omitempty"`
+	Subtask        bool   `json:"subtask,omitempty"`
+	HierarchyLevel *int   `json:"hierarchyLevel,omitempty"`
 }
 
-// DoOneIssueTypeRequest returns the type id associated with field names.
+// DoOneIssueTypeRequest returns the issue types known to jira.
 func (jb *JiraBoss) DoOneIssueTypeRequest() ([]IssueTypeFields, error) {
 	body, err := jb.punchItChewie(http.MethodGet, nil, endpointIssueType)
 	if err != nil {
@@ -380,7 +443,10 @@ func makePlaceHolderEpic(i int, project string) *ResponseIssue {
 }
 
 // WriteDates actually writes new dates to jira.
+// In a dry run the writes are attempted, to print them,
+// as if --go had been given.
 func (jb *JiraBoss) WriteDates(doIt bool, nodes map[MyKey]*Node) error {
+	doIt = doIt || jb.DryRun()
 	var lastErr error
 	proposedChangeCount := 0
 	success := 0
@@ -451,6 +517,7 @@ func (jb *JiraBoss) SetDates(
 func (jb *JiraBoss) CheckIssues(im map[MyKey]IssueList) error {
 	foundLookupError := false
 	foundTypeError := false
+	p := make(problems)
 	count := 0
 	for epicKey, issueList := range im {
 		count++
@@ -462,33 +529,35 @@ func (jb *JiraBoss) CheckIssues(im map[MyKey]IssueList) error {
 		)
 		resp, err = jb.GetOneIssue(epicKey.Num)
 		if err != nil {
-			utils.DoErrF("Could not find epic %s", epicKey.String())
+			p.add(epicKey, "Could not find epic %s", epicKey.String())
 			foundLookupError = true
-		} else if !resp.IsEpic() {
-			utils.DoErrF("Why is the non-epic %s in the issue keys?\n", epicKey)
+		} else if !jb.IsEpic(resp) {
+			p.add(epicKey, "Why is the non-epic %s in the issue keys?", epicKey)
 			foundTypeError = true
 		}
 		for _, issue := range issueList {
 			resp, err = jb.GetOneIssue(issue.MyKey.Num)
 			if err != nil {
-				utils.DoErrF("Could not find issue %s", issue.Key)
+				p.add(issue.MyKey, "Could not find issue %s", issue.Key)
 				foundLookupError = true
 				continue
 			}
 
-			if !resp.IsOkayUnderEpic() {
+			if !jb.IsOkayUnderEpic(resp) {
+				p.add(issue.MyKey, "Issue %s is a %s, which can't be under an epic",
+					issue.Key, resp.TypeRaw())
 				foundTypeError = true
 				continue
 			}
 		}
 	}
 	if foundLookupError {
-		return fmt.Errorf(
+		return p.asError(
 			`aborting write due to lookup errors;
  fix issue numbers or create placeholder issues`)
 	}
 	if foundTypeError {
-		return fmt.Errorf(`aborting write due type errors;
+		return p.asError(`aborting write due type errors;
  if you want to overwrite the types, delete this line`)
 	}
 	return nil
@@ -499,126 +568,78 @@ func (jb *JiraBoss) GetOneIssue(issue int) (*ResponseIssue, error) {
 	return jb.GetOneIssueByKey(jb.Key(issue))
 }
 
-// GetOneIssueByKey recovers info about the issue.
-func (jb *JiraBoss) GetOneIssueByKey(issue MyKey) (*ResponseIssue, error) {
-	var (
-		err  error
-		resp ResponseIssue
-		body []byte
-	)
-	body, err = jb.punchItChewie(
-		http.MethodGet, nil,
-		endpointIssue+"/"+issue.String())
+// GetOneIssueWithChangelog is GetOneIssue with the issue's changelog.
+func (jb *JiraBoss) GetOneIssueWithChangelog(issue int) (*ResponseIssue, error) {
+	return jb.getIssueWithChangelog(jb.Key(issue).String())
+}
+
+func (jb *JiraBoss) getIssueWithChangelog(key string) (*ResponseIssue, error) {
+	body, err := jb.punchItChewie(
+		http.MethodGet, nil, endpointIssue+"/"+key+"?expand=changelog")
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(body, &resp)
-	if err != nil {
+	var resp ResponseIssue
+	if err = json.Unmarshal(body, &resp); err != nil {
 		return nil, fmt.Errorf("trouble unmarshaling issue; %w", err)
 	}
 	resp.SetMyKey()
 	return &resp, nil
 }
 
-// GetTransitionId finds the id of some transition.
-func (jb *JiraBoss) GetTransitionId(issue int, status IssueStatus) (string, error) {
-	type transition struct {
-		Id   string `json:"id"`
-		Name string `json:"name"`
+// LoadChangelogs fetches the changelogs of the given issues,
+// attaching each to its issue.
+func (jb *JiraBoss) LoadChangelogs(im map[MyKey]*ResponseIssue) error {
+	keys := make([]MyKey, 0, len(im))
+	for k := range im {
+		keys = append(keys, k)
 	}
-	type tranReq struct {
-		Transitions []transition `json:"transitions"`
+	for len(keys) > 0 {
+		// Keep the query a reasonable length.
+		n := min(len(keys), maxResult)
+		found, err := jb.DoPagedSearchWithChangelog(termKeys(keys[:n]).String())
+		if err != nil {
+			return err
+		}
+		for i := range found {
+			if issue, ok := im[found[i].MyKey]; ok {
+				issue.Changelog = found[i].Changelog
+			}
+		}
+		keys = keys[n:]
 	}
+	return nil
+}
+
+// GetOneIssueByKey recovers info about the issue.
+func (jb *JiraBoss) GetOneIssueByKey(issue MyKey) (*ResponseIssue, error) {
 	var (
 		err  error
-		req  tranReq
+		resp ResponseIssue
 		body []byte
 	)
 	body, err = jb.punchItChewie(
 		http.MethodGet, nil,
-		endpointIssue+"/"+jb.Key(issue).String()+"/transitions")
+		endpointIssue+"/"+issue.String())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	err = json.Unmarshal(body, &req)
+	err = json.Unmarshal(body, &resp)
 	if err != nil {
-		return "", fmt.Errorf("trouble unmarshaling issue; %w", err)
-	}
-	for _, t := range req.Transitions {
-		if strings.HasPrefix(t.Name, status.String()) {
-			return t.Id, nil
-		}
+		return nil, fmt.Errorf("trouble unmarshaling issue; %w", err)
 	}
-	return "", fmt.Errorf("unable to find transition to %q", status)
+	resp.SetMyKey()
+	return &resp, nil
 }
 
 // BlockIssues makes the first issue block the others.
 func (jb *JiraBoss) BlockIssues(blocker int, toBeBlocked []int, comment string) error {
-	var req struct {
-		Type struct {
-			Name string `json:"name"`
-		} `json:"type"`
-		// InwardIssue is the issue doing the blocking
-		InwardIssue struct {
-			Key string `json:"key"`
-		} `json:"inwardIssue"`
-		// OutwardIssue is the issue being blocked.
-		OutwardIssue struct {
-			Key string `json:"key"`
-		} `json:"outwardIssue"`
-		Comment struct {
-			Body string `json:"body,omitempty"`
-		} `json:"comment,omitempty"`
-	}
-	req.Type.Name = LinkTypeBlocks
-	req.InwardIssue.Key = jb.Key(blocker).String()
-	if comment != "" {
-		req.Comment.Body = comment
-	}
-	for _, dependent := range toBeBlocked {
-		req.OutwardIssue.Key = jb.Key(dependent).String()
-		_, err := jb.punchItChewie(http.MethodPost, &req, endpointIssueLink)
-		if err != nil {
-			return err
-		}
-	}
-	utils.DoErrF("%d now blocks %v\n", blocker, toBeBlocked)
-	return nil
+	return jb.LinkIssues(&linkTypeBlocks, blocker, toBeBlocked, comment)
 }
 
 // UnBlockIssues deletes the links created by BlockIssues.
 func (jb *JiraBoss) UnBlockIssues(blocker int, blocked []int) error {
-	var (
-		err  error
-		body []byte
-		resp ResponseIssue
-	)
-	body, err = jb.punchItChewie(
-		http.MethodGet, nil,
-		endpointIssue+"/"+jb.Key(blocker).String()+"?expand=issuelinks")
-	if err != nil {
-		return err
-	}
-	if err = json.Unmarshal(body, &resp); err != nil {
-		return fmt.Errorf("trouble unmarshaling issue links; %w", err)
-	}
-	count := 0
-	for _, issue := range blocked {
-		key := jb.Key(issue)
-		for _, link := range resp.Fields.IssueLinks {
-			if link.Type.Name == LinkTypeBlocks &&
-				link.OutwardIssue.Key == key.String() {
-				if err = jb.deleteLink(link.Id); err != nil {
-					return err
-				}
-				count++
-				utils.DoErrF("%d no longer blocks %v\n", blocker, issue)
-				break
-			}
-		}
-	}
-	utils.DoErrF("Deleted %d blocking links.\n", count)
-	return nil
+	return jb.UnLinkIssues(&linkTypeBlocks, blocker, blocked)
 }
 
 func (jb *JiraBoss) deleteLink(id string) error {
@@ -626,19 +647,6 @@ func (jb *JiraBoss) deleteLink(id string) error {
 	return err
 }
 
-// MoveIssueToState moves an issue to a new state
-func (jb *JiraBoss) MoveIssueToState(n int, stateId string) error {
-	var req struct {
-		Transition struct {
-			Id string `json:"id"`
-		} `json:"transition"`
-	}
-	req.Transition.Id = stateId
-	_, err := jb.punchItChewie(
-		http.MethodPost, &req, endpointIssue+"/"+jb.Key(n).String()+"/transitions")
-	return err
-}
-
 // GetOneIssueEditMeta recovers metadata (field accessibility)
 // about the issue called {project}-{id}.
 func (jb *JiraBoss) GetOneIssueEditMeta(issue int) (*ResponseEditMeta, error) {
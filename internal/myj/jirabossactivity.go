@@ -0,0 +1,127 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// https://developer.atlassian.com/server/jira/platform/rest/v10004/api-group-myself/#api-group-myself
+const endpointMyself = "rest/api/2/myself"
+
+// GetMyself returns the name (e.g. ldap) of the user owning the access token.
+func (jb *JiraBoss) GetMyself() (string, error) {
+	body, err := jb.punchItChewie(http.MethodGet, nil, endpointMyself)
+	if err != nil {
+		return "", err
+	}
+	var resp humanUser
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("trouble unmarshaling user; %w", err)
+	}
+	if resp.Name == "" {
+		return "", fmt.Errorf("unable to determine user from token")
+	}
+	return resp.Name, nil
+}
+
+// Activity holds the issues a user touched in some time range.
+// Each kind of activity maps epic keys to the issues in that epic.
+type Activity struct {
+	User      string
+	Range     *utils.DayRange
+	Created   map[MyKey]IssueList
+	Commented map[MyKey]IssueList
+	Resolved  map[MyKey]IssueList
+	// Epics holds the epics named in the maps above, if they could be found.
+	Epics map[MyKey]*ResponseIssue
+}
+
+// GetActivity returns the issues the user created, commented on
+// and resolved during the given time range.
+func (jb *JiraBoss) GetActivity(
+	user string, dr *utils.DayRange) (*Activity, error) {
+	result := &Activity{
+		User:  user,
+		Range: dr,
+		Epics: make(map[MyKey]*ResponseIssue),
+	}
+	var err error
+	if result.Created, err = jb.searchByEpic(
+		jqlIssuesCreated(user, dr), result.Epics); err != nil {
+		return nil, err
+	}
+	if result.Commented, err = jb.searchByEpic(
+		jqlIssuesCommented(user, dr), result.Epics); err != nil {
+		return nil, err
+	}
+	if result.Resolved, err = jb.searchByEpic(
+		jqlIssuesClosed(user, dr), result.Epics); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// searchByEpic returns the issues found by the query grouped by epic.
+// An epic found by the query is grouped under itself, and issues
+// without an epic go under the placeholder epic.  Epics not already
+// in the given map are looked up and added to it.
+func (jb *JiraBoss) searchByEpic(
+	jql string, epics map[MyKey]*ResponseIssue) (map[MyKey]IssueList, error) {
+	issues, err := jb.DoPagedSearch(jql)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[MyKey]IssueList)
+	for i := range issues {
+		issue := &issues[i]
+		epicKey := issue.MyKey
+		if !jb.IsEpic(issue) {
+			epicKey = jb.DetermineEpicLink(issue)
+		}
+		if _, ok := epics[epicKey]; !ok && epicKey.Num < UnknownEpicBase {
+			if jb.IsEpic(issue) {
+				epics[epicKey] = issue
+			} else if epic, err := jb.GetOneIssueByKey(epicKey); err == nil {
+				epics[epicKey] = epic
+			} else {
+				// Most likely no permission to see it.
+				epics[epicKey] = nil
+			}
+		}
+		result[epicKey] = append(result[epicKey], issue)
+	}
+	return result, nil
+}
+
+// GetWeeklyThroughput returns the number of the project's stories
+// resolved in each of the given number of weeks up through today,
+// oldest week first.
+func (jb *JiraBoss) GetWeeklyThroughput(weeks int) ([]int, error) {
+	today := utils.Today()
+	dr, err := utils.MakeDayRangeSimple(today.AddDays(1-7*weeks), 7*weeks)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := jb.DoPagedSearch(jb.jqlIssuesResolved(dr))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]int, weeks)
+	for i := range issues {
+		d := issues[i].DateResolved()
+		if !dr.Contains(d) {
+			continue
+		}
+		result[(dr.Start().DayCount(d)-1)/7]++
+	}
+	return result, nil
+}
+
+// GetIssuesResolved returns the project's non-epic issues resolved
+// during the range, with their changelogs.
+func (jb *JiraBoss) GetIssuesResolved(dr *utils.DayRange) ([]ResponseIssue, error) {
+	return jb.DoPagedSearchWithChangelog(jb.jqlIssuesResolved(dr))
+}
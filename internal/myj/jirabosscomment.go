@@ -0,0 +1,120 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// https://developer.atlassian.com/server/jira/platform/rest/v10004/api-group-issue/#api-api-2-issue-issueidorkey-comment-get
+const pathComment = "comment"
+
+type Comment struct {
+	Id      string    `json:"id,omitempty"`
+	Author  humanUser `json:"author,omitempty"`
+	Body    string    `json:"body"`
+	Created string    `json:"created,omitempty"`
+	Updated string    `json:"updated,omitempty"`
+}
+
+// AuthorName is the display name of the comment's author.
+func (c *Comment) AuthorName() string {
+	if c.Author.DisplayName != "" {
+		return c.Author.DisplayName
+	}
+	return c.Author.Name
+}
+
+// DateCreated returns the day the comment was made.
+func (c *Comment) DateCreated() utils.Date {
+	return utils.FromTimestampOrEpic(c.Created)
+}
+
+// WasEdited is true if the comment changed after it was made.
+func (c *Comment) WasEdited() bool {
+	return c.Updated != "" && c.Updated != c.Created
+}
+
+func (jb *JiraBoss) commentPath(issue int) string {
+	return endpointIssue + "/" + jb.Key(issue).String() + "/" + pathComment
+}
+
+// GetComments returns the issue's comments, oldest first.
+func (jb *JiraBoss) GetComments(issue int) ([]Comment, error) {
+	var result []Comment
+	for {
+		body, err := jb.punchItChewie(
+			http.MethodGet, nil,
+			jb.commentPath(issue)+"?startAt="+strconv.Itoa(len(result)))
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			Comments []Comment `json:"comments"`
+			Total    int       `json:"total"`
+		}
+		if err = json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("trouble unmarshaling comments; %w", err)
+		}
+		result = append(result, resp.Comments...)
+		if len(resp.Comments) == 0 || len(result) >= resp.Total {
+			return result, nil
+		}
+	}
+}
+
+// GetComment returns one of the issue's comments.
+func (jb *JiraBoss) GetComment(issue int, id string) (*Comment, error) {
+	body, err := jb.punchItChewie(
+		http.MethodGet, nil, jb.commentPath(issue)+"/"+id)
+	if err != nil {
+		return nil, err
+	}
+	var resp Comment
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("trouble unmarshaling comment; %w", err)
+	}
+	return &resp, nil
+}
+
+// commentRequest is the body of a request to add or edit a comment;
+// a Comment would also send its empty author.
+type commentRequest struct {
+	Body string `json:"body"`
+}
+
+// AddComment adds a comment to each of the issues.  If one fails,
+// the error names the issues already commented on.
+func (jb *JiraBoss) AddComment(issues []int, text string) error {
+	req := commentRequest{Body: text}
+	for i, issue := range issues {
+		if _, err := jb.punchItChewie(
+			http.MethodPost, &req, jb.commentPath(issue)); err != nil {
+			if i > 0 {
+				return fmt.Errorf("commenting on %s, after commenting on %v; %w",
+					jb.Key(issue), issues[:i], err)
+			}
+			return fmt.Errorf("commenting on %s; %w", jb.Key(issue), err)
+		}
+	}
+	utils.DoErrF("commented on %v\n", issues)
+	return nil
+}
+
+// EditComment replaces the text of one of the issue's comments.
+func (jb *JiraBoss) EditComment(issue int, id string, text string) error {
+	req := commentRequest{Body: text}
+	_, err := jb.punchItChewie(
+		http.MethodPut, &req, jb.commentPath(issue)+"/"+id)
+	return err
+}
+
+// DeleteComment deletes one of the issue's comments.
+func (jb *JiraBoss) DeleteComment(issue int, id string) error {
+	_, err := jb.punchItChewie(
+		http.MethodDelete, nil, jb.commentPath(issue)+"/"+id)
+	return err
+}
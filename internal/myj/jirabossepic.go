@@ -18,6 +18,15 @@ func (jb *JiraBoss) GetEpics() (result map[MyKey]*ResponseIssue) {
 	return jb.makeEpicMap(epics, false)
 }
 
+// GetFilteredEpics is GetEpics narrowed by the given filter.
+func (jb *JiraBoss) GetFilteredEpics(f *EpicFilter) map[MyKey]*ResponseIssue {
+	epics, err := jb.DoPagedSearch(jb.jqlEpicsFiltered(f))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return jb.makeEpicMap(epics, false)
+}
+
 // GetEpicsWithPlaceholder returns GetEpics plus a known placeholder
 // to accumulate orphan stories.
 func (jb *JiraBoss) GetEpicsWithPlaceholder() (result map[MyKey]*ResponseIssue) {
@@ -86,6 +95,21 @@ func (jb *JiraBoss) GetIssuesGroupedByEpic(epics map[MyKey]*ResponseIssue) (
 	return
 }
 
+// GetAllIssuesInEpic returns all the issues in the epic, including
+// those that are done.
+func (jb *JiraBoss) GetAllIssuesInEpic(epic int) (IssueList, error) {
+	issues, err := jb.DoPagedSearch(jb.jqlAllIssuesInEpic(jb.Key(epic).String()))
+	if err != nil {
+		return nil, err
+	}
+	result := make(IssueList, len(issues))
+	for i := range issues {
+		result[i] = &issues[i]
+	}
+	sort.Sort(result)
+	return result, nil
+}
+
 // SetEpicLink PUTs an issue to modify the epic link.
 func (jb *JiraBoss) SetEpicLink(issue int, epic int) (err error) {
 	type fieldsToWrite struct {
@@ -145,8 +169,13 @@ func (jb *JiraBoss) FixEpicName(epic int) error {
 }
 
 func (jb *JiraBoss) CheckEpics(em map[MyKey]*ResponseIssue) error {
+	catalog, err := jb.GetCatalog()
+	if err != nil {
+		return err
+	}
 	foundLookupError := false
 	foundEpicError := false
+	p := make(problems)
 	for epicKey, epic := range em {
 		var (
 			resp *ResponseIssue
@@ -154,46 +183,46 @@ func (jb *JiraBoss) CheckEpics(em map[MyKey]*ResponseIssue) error {
 		)
 		resp, err = jb.GetOneIssue(epicKey.Num)
 		if err != nil {
-			utils.DoErrF("Could not find epic %s", epicKey.String())
+			p.add(epicKey, "Could not find epic %s", epicKey.String())
 			foundLookupError = true
 			continue
 		}
 		if resp.Key != epic.Key {
-			utils.DoErrF("Key mismatch %s != %s\n", resp.Key, epic.Key)
+			p.add(epicKey, "Key mismatch %s != %s", resp.Key, epic.Key)
 			foundLookupError = true
 			continue
 		}
 		{
 			str, ok := epic.Fields.CustomEpicLink.(string)
 			if ok && str != "" {
-				utils.DoErrF("Epic %s should not have an epic link (its %s)\n",
+				p.add(epicKey, "Epic %s should not have an epic link (its %s)",
 					epicKey.String(), str)
 				foundEpicError = true
 			}
 		}
 		if epic.Fields.Summary == "" {
-			utils.DoErrF("Epic %s should have a summary\n", epicKey.String())
+			p.add(epicKey, "Epic %s should have a summary", epicKey.String())
 			foundEpicError = true
 		}
-		if !epic.IsEpic() {
-			utils.DoErrF("Epic %s should have type %q, not %q\n",
+		if !jb.IsEpic(epic) {
+			p.add(epicKey, "Epic %s should have type %q, not %q",
 				epicKey.String(), IssueTypeEpic, epic.TypeRaw())
 			foundEpicError = true
 		}
-		if epic.Status() == IssueStatusUnknown {
-			utils.DoErrF("Epic %s has bad status %q\n",
+		if _, ok := catalog.Status(epic.StatusRaw()); !ok {
+			p.add(epicKey, "Epic %s has bad status %q",
 				epicKey.String(), epic.StatusRaw())
 			foundEpicError = true
 		}
 		// epic contains the data to write
 	}
 	if foundLookupError {
-		return fmt.Errorf(
+		return p.asError(
 			`aborting write due to lookup errors;
  fix issue numbers or create placeholder issues`)
 	}
 	if foundEpicError {
-		return fmt.Errorf(`aborting write due to epic errors;
+		return p.asError(`aborting write due to epic errors;
  if you want to overwrite the types, delete this line`)
 	}
 	return nil
@@ -214,7 +243,7 @@ func (jb *JiraBoss) CreateDiGraph() (*Graph, error) {
 		if err != nil {
 			return nil, err
 		}
-		if !issue.IsEpic() {
+		if !jb.IsEpic(issue) {
 			err = fmt.Errorf(
 				"GetEpics returned %s which is not an Epic",
 				issue.Key)
@@ -227,6 +256,7 @@ func (jb *JiraBoss) CreateDiGraph() (*Graph, error) {
 
 // considerEpic adds the incoming epic to a graph (if not already seen),
 // then looks for other epics that block it (other epics it depends on).
+// Links of any of the dependency link types count as blocking.
 func (jb *JiraBoss) considerEpic(
 	epic *ResponseIssue, visited map[MyKey]*Node, edges map[Edge]bool) {
 	if _, seen := visited[epic.MyKey]; seen {
@@ -238,35 +268,32 @@ func (jb *JiraBoss) considerEpic(
 		// don't recurse into issues from other projects
 		return
 	}
-	for _, link := range epic.Fields.IssueLinks {
-		if link.Type.Name == LinkTypeBlocks && link.InwardIssue.Key != "" {
-			// The incoming epic is blocked by the other
-			other := ParseMyKey(link.InwardIssue.Key)
-			issue, err := jb.GetOneIssueByKey(other)
-			if err != nil {
-				err = fmt.Errorf(
-					"in epic %s, unable to look up blocker %s; %w",
-					epicKey, other, err)
-				utils.DoErr1(err.Error())
-				continue
-			}
-			if other != issue.MyKey {
-				panic(fmt.Errorf(
-					"looked up %s, got %s",
-					other.String(), issue.MyKey.String()))
-			}
-			if !issue.IsEpic() {
-				// Don't include non-epics in the graph, even if they are
-				// blockers, because the graph might feed into other functions
-				// like fixing dates, and we cannot expect date fields on
-				// non-epics to be meaningful. Perhaps control this with flag.
-				utils.DoErrF(
-					"in epic %s, ignoring blockage by (non-epic) %s %s (%s)\n",
-					epicKey, issue.Type(), issue.MyKey, issue.Status())
-				continue
-			}
-			edges[Edge{parent: issue.MyKey, child: epicKey}] = true
-			jb.considerEpic(issue, visited, edges)
+	for _, other := range epic.DependsOn(jb.DependencyLinkTypes()) {
+		// The incoming epic is blocked by the other
+		issue, err := jb.GetOneIssueByKey(other)
+		if err != nil {
+			err = fmt.Errorf(
+				"in epic %s, unable to look up blocker %s; %w",
+				epicKey, other, err)
+			utils.DoErr1(err.Error())
+			continue
+		}
+		if other != issue.MyKey {
+			panic(fmt.Errorf(
+				"looked up %s, got %s",
+				other.String(), issue.MyKey.String()))
+		}
+		if !jb.IsEpic(issue) {
+			// Don't include non-epics in the graph, even if they are
+			// blockers, because the graph might feed into other functions
+			// like fixing dates, and we cannot expect date fields on
+			// non-epics to be meaningful. Perhaps control this with flag.
+			utils.DoErrF(
+				"in epic %s, ignoring blockage by (non-epic) %s %s (%s)\n",
+				epicKey, issue.TypeRaw(), issue.MyKey, issue.StatusRaw())
+			continue
 		}
+		edges[Edge{parent: issue.MyKey, child: epicKey}] = true
+		jb.considerEpic(issue, visited, edges)
 	}
 }
@@ -1,35 +1,88 @@
 package myj
 
 import (
-	"fmt"
-	"strings"
-
+	"github.com/monopole/gojira/internal/jql"
 	"github.com/monopole/gojira/internal/utils"
 )
 
 func (jb *JiraBoss) jqlEpics() string {
-	return andTerms(
-		termString("project", RelEqual, jb.Project()),
-		termType(RelEqual, IssueTypeEpic),
-	)
+	return jql.And(jb.inProject(), isType(IssueTypeEpic)).String()
+}
+
+// EpicFilter narrows the set of epics returned by GetFilteredEpics.
+// Empty fields don't narrow anything.
+type EpicFilter struct {
+	Statuses  []string
+	Labels    []string
+	Assignees []string
+	HideDone  bool
+	// Jql is arbitrary JQL ANDed with everything else.
+	Jql string
+}
+
+func (jb *JiraBoss) jqlEpicsFiltered(f *EpicFilter) string {
+	var notDone jql.Clause
+	if f.HideDone {
+		notDone = termNotDone()
+	}
+	return jql.And(
+		jb.inProject(),
+		isType(IssueTypeEpic),
+		jql.In("status", f.Statuses...),
+		jql.In("labels", f.Labels...),
+		jql.In("assignee", f.Assignees...),
+		notDone,
+		jql.Raw(f.Jql),
+	).String()
+}
+
+// jqlSelection limits arbitrary JQL to the project's issues.
+func (jb *JiraBoss) jqlSelection(query string) string {
+	return jql.And(jb.inProject(), jql.Raw(query)).String()
 }
 
 func (jb *JiraBoss) jqlIssues() string {
-	return andTerms(
-		termString("project", RelEqual, jb.Project()),
-		termType(RelNotEqual, IssueTypeEpic),
-		termStatus(RelNotEqual, IssueStatusDone),
-		termStatus(RelNotEqual, IssueStatusClosedWoAction),
-	)
+	return jql.And(
+		jb.inProject(),
+		jql.NotEq("issuetype", IssueTypeEpic.String()),
+		termNotDone(),
+	).String()
 }
 
 func (jb *JiraBoss) jqlIssuesInEpic(epic string) string {
-	return andTerms(
-		termString("project", RelEqual, jb.Project()),
-		termString(CustomFieldEpicLink, RelEqual, epic),
-		termStatus(RelNotEqual, IssueStatusDone),
-		termStatus(RelNotEqual, IssueStatusClosedWoAction),
-	)
+	return jql.And(
+		jb.inProject(),
+		jql.Eq(CustomFieldEpicLink, epic),
+		termNotDone(),
+	).String()
+}
+
+// jqlIssuesInEpics is jqlIssuesInEpic for several epics at once.
+// The keys mustn't be empty, or this matches every open issue.
+func (jb *JiraBoss) jqlIssuesInEpics(epics []MyKey) string {
+	return jql.And(
+		jb.inProject(),
+		jql.In(CustomFieldEpicLink, keyNames(epics)...),
+		termNotDone(),
+	).String()
+}
+
+// jqlAllIssuesInEpic is jqlIssuesInEpic including the done issues.
+func (jb *JiraBoss) jqlAllIssuesInEpic(epic string) string {
+	return jql.And(
+		jb.inProject(),
+		jql.Eq(CustomFieldEpicLink, epic),
+	).String()
+}
+
+// jqlIssuesResolved finds the project's non-epic issues resolved in the range.
+func (jb *JiraBoss) jqlIssuesResolved(dayRange *utils.DayRange) string {
+	return jql.And(
+		jb.inProject(),
+		jql.NotEq("issuetype", IssueTypeEpic.String()),
+		jql.Term("resolved", jql.OpGreaterOrEqual, jql.Date(dayRange.Start())),
+		jql.Term("resolved", jql.OpLess, jql.Date(dayRange.End().AddDays(1))),
+	).String()
 }
 
 // Time range queries are tricky - must add one day to the end so that the query
@@ -51,45 +104,52 @@ func jqlIssuesCreated(user string, dayRange *utils.DayRange) string {
 	// the creator cannot change, but the reporter can change.
 	// So maybe use reporter instead of creator?
 	// see :  https://support.atlassian.com/jira-software-cloud/docs/jql-fields/
-	return fmt.Sprintf(
-		"creator = %s and created >= '%s' and created < '%s'",
-		user,
-		dayRange.Start().JiraFormat(),
-		dayRange.End().AddDays(1).JiraFormat())
+	return jql.And(
+		jql.Eq("creator", user),
+		jql.Term("created", jql.OpGreaterOrEqual, jql.Date(dayRange.Start())),
+		jql.Term("created", jql.OpLess, jql.Date(dayRange.End().AddDays(1))),
+	).String()
 }
 
 func jqlIssuesCommented(user string, dayRange *utils.DayRange) string {
-	return fmt.Sprintf(
-		"creator != %s and issuefunction in commented (' by %s after %s') and issuefunction in commented ('by %s before %s')",
-		user,
-		user,
-		dayRange.Start().JiraFormat(),
-		user,
-		dayRange.End().AddDays(1).JiraFormat(),
-	)
+	return jql.And(
+		jql.NotEq("creator", user),
+		jql.IssueFunction("commented",
+			"by "+user+" after "+dayRange.Start().JiraFormat()),
+		jql.IssueFunction("commented",
+			"by "+user+" before "+dayRange.End().AddDays(1).JiraFormat()),
+	).String()
 }
 
 func jqlIssuesClosed(user string, dayRange *utils.DayRange) string {
-	return fmt.Sprintf(
-		"status WAS 'Resolved' BY %s DURING ('%s','%s')",
-		user,
-		dayRange.Start().JiraFormat(),
-		dayRange.End().AddDays(1).JiraFormat(),
-	)
+	return jql.Term("status", jql.OpWas,
+		jql.Str("Resolved").By(user).During(
+			jql.Date(dayRange.Start()), jql.Date(dayRange.End().AddDays(1))),
+	).String()
+}
+
+func (jb *JiraBoss) inProject() jql.Clause {
+	return jql.Eq("project", jb.Project())
 }
 
-func andTerms(s ...string) string {
-	return strings.Join(s, " AND ")
+func isType(t IssueType) jql.Clause {
+	return jql.Eq("issuetype", t.String())
 }
 
-func termString(k string, r Rel, s string) string {
-	return fmt.Sprintf("%q %s %q", k, r, s)
+func termKeys(keys []MyKey) jql.Clause {
+	return jql.In("key", keyNames(keys)...)
 }
 
-func termType(r Rel, t IssueType) string {
-	return fmt.Sprintf("issuetype %s %q", r, t)
+func keyNames(keys []MyKey) []string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.String()
+	}
+	return names
 }
 
-func termStatus(r Rel, s IssueStatus) string {
-	return fmt.Sprintf("status %s %q", r, s)
+// termNotDone matches issues whose status isn't in the done
+// category, whatever the project calls its statuses.
+func termNotDone() jql.Clause {
+	return jql.NotEq("statusCategory", "Done")
 }
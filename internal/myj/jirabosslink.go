@@ -0,0 +1,174 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// https://developer.atlassian.com/server/jira/platform/rest/v10004/api-group-issuelinktype/#api-group-issuelinktype
+const endpointIssueLinkType = "rest/api/2/issueLinkType"
+
+// LinkType is a kind of link between issues, e.g. "Blocks", with
+// Outward "blocks" and Inward "is blocked by".
+type LinkType struct {
+	Id      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Inward  string `json:"inward,omitempty"`
+	Outward string `json:"outward,omitempty"`
+}
+
+// linkTypeBlocks is jira's standard link type, used
+// without asking jira about it.
+var linkTypeBlocks = LinkType{
+	Name: LinkTypeBlocks, Inward: "is blocked by", Outward: "blocks"}
+
+// GetLinkTypes returns the link types known to the jira instance.
+func (jb *JiraBoss) GetLinkTypes() ([]LinkType, error) {
+	body, err := jb.punchItChewie(http.MethodGet, nil, endpointIssueLinkType)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		IssueLinkTypes []LinkType `json:"issueLinkTypes"`
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("trouble unmarshaling link types; %w", err)
+	}
+	return resp.IssueLinkTypes, nil
+}
+
+// FindLinkType returns the link type with the given name, ignoring case.
+func (jb *JiraBoss) FindLinkType(name string) (*LinkType, error) {
+	types, err := jb.GetLinkTypes()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for i := range types {
+		if strings.EqualFold(types[i].Name, name) {
+			return &types[i], nil
+		}
+		names = append(names, types[i].Name)
+	}
+	return nil, fmt.Errorf("unknown link type %q; use one of %s",
+		name, strings.Join(names, ", "))
+}
+
+// LinkIssues links the first issue to the others with the link type.
+// The first issue is the inward one, e.g. for "Blocks" it's the blocker.
+func (jb *JiraBoss) LinkIssues(t *LinkType, from int, to []int, comment string) error {
+	var req struct {
+		Type struct {
+			Name string `json:"name"`
+		} `json:"type"`
+		InwardIssue struct {
+			Key string `json:"key"`
+		} `json:"inwardIssue"`
+		OutwardIssue struct {
+			Key string `json:"key"`
+		} `json:"outwardIssue"`
+		Comment struct {
+			Body string `json:"body,omitempty"`
+		} `json:"comment,omitempty"`
+	}
+	req.Type.Name = t.Name
+	req.InwardIssue.Key = jb.Key(from).String()
+	if comment != "" {
+		req.Comment.Body = comment
+	}
+	for _, other := range to {
+		req.OutwardIssue.Key = jb.Key(other).String()
+		_, err := jb.punchItChewie(http.MethodPost, &req, endpointIssueLink)
+		if err != nil {
+			return err
+		}
+	}
+	utils.DoErrF("%d now %s %v\n", from, t.Outward, to)
+	return nil
+}
+
+// UnLinkIssues deletes the links created by LinkIssues.
+func (jb *JiraBoss) UnLinkIssues(t *LinkType, from int, to []int) error {
+	var (
+		err  error
+		body []byte
+		resp ResponseIssue
+	)
+	body, err = jb.punchItChewie(
+		http.MethodGet, nil,
+		endpointIssue+"/"+jb.Key(from).String()+"?expand=issuelinks")
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("trouble unmarshaling issue links; %w", err)
+	}
+	count := 0
+	for _, issue := range to {
+		key := jb.Key(issue)
+		for _, link := range resp.Fields.IssueLinks {
+			if strings.EqualFold(link.Type.Name, t.Name) &&
+				link.OutwardIssue.Key == key.String() {
+				if err = jb.deleteLink(link.Id); err != nil {
+					return err
+				}
+				count++
+				utils.DoErrF("%d no longer %s %v\n", from, t.Outward, issue)
+				break
+			}
+		}
+	}
+	utils.DoErrF("Deleted %d %s links.\n", count, t.Name)
+	return nil
+}
+
+// LinkGroup holds the issues linked to some issue in one direction
+// of one link type.
+type LinkGroup struct {
+	// Label describes the link from the issue's point of view,
+	// e.g. "is blocked by".
+	Label string
+	Keys  []MyKey
+}
+
+// LinkGroups returns the issue's links grouped by label, sorted by label.
+func (ri *ResponseIssue) LinkGroups() []LinkGroup {
+	groups := make(map[string][]MyKey)
+	for _, link := range ri.Fields.IssueLinks {
+		label, key := link.Type.Outward, link.OutwardIssue.Key
+		if link.InwardIssue.Key != "" {
+			label, key = link.Type.Inward, link.InwardIssue.Key
+		}
+		if key == "" {
+			continue
+		}
+		if label == "" {
+			label = link.Type.Name
+		}
+		groups[label] = append(groups[label], ParseMyKey(key))
+	}
+	result := make([]LinkGroup, 0, len(groups))
+	for label, keys := range groups {
+		result = append(result, LinkGroup{Label: label, Keys: keys})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Label < result[j].Label })
+	return result
+}
+
+// DependsOn returns the keys of the issues this one depends on; those
+// on the inward side of links of the given types.
+func (ri *ResponseIssue) DependsOn(linkTypes []string) (result []MyKey) {
+	for _, link := range ri.Fields.IssueLinks {
+		if link.InwardIssue.Key != "" && slices.ContainsFunc(linkTypes,
+			func(t string) bool { return strings.EqualFold(t, link.Type.Name) }) {
+			result = append(result, ParseMyKey(link.InwardIssue.Key))
+		}
+	}
+	return
+}
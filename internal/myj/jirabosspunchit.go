@@ -29,6 +29,23 @@ func (jb *JiraBoss) punchItChewie(
 	if req != nil && utils.Debug {
 		dump("REQUEST", body)
 	}
+	if jb.args.DryRun && isWrite(method, path) {
+		utils.DoErrF("Would %s %s\n", method, path)
+		if req != nil {
+			var pretty bytes.Buffer
+			if json.Indent(&pretty, body, "  ", "  ") == nil {
+				utils.DoErrF("  %s\n", pretty.String())
+			}
+		}
+		// Pretend it worked, so the command carries on.
+		return []byte("{}"), nil
+	}
+	var entry *JournalEntry
+	if jb.args.JournalPath != "" && isWrite(method, path) {
+		if entry, err = jb.prepareEntry(method, path, body); err != nil {
+			return nil, err
+		}
+	}
 	ans, err = jb.doRequest(method, loc, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
@@ -40,6 +57,9 @@ func (jb *JiraBoss) punchItChewie(
 	if utils.Debug {
 		dump("RESPONSE", body)
 	}
+	if entry != nil {
+		jb.recordEntry(entry, body)
+	}
 	return body, nil
 }
 
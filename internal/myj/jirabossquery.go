@@ -0,0 +1,93 @@
+package myj
+
+import (
+	"strings"
+
+	"github.com/monopole/gojira/internal/jql"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// Assignee values with special meaning in an IssueFilter.
+const (
+	AssigneeMe   = "me"
+	AssigneeNone = "none"
+)
+
+// IssueFilter selects the project's issues for QueryIssues.
+// Empty fields don't narrow anything.
+type IssueFilter struct {
+	Types    []string
+	Statuses []string
+	Labels   []string
+	// Assignees may include AssigneeMe and AssigneeNone.
+	Assignees []string
+	// Epic, if not zero, is the number of the issues' epic.
+	Epic int
+	// UpdatedSince, if not nil, is the earliest day of the last update.
+	UpdatedSince *utils.Date
+	// Text is searched for in summaries, descriptions and comments.
+	Text     string
+	HideDone bool
+	// Jql is arbitrary JQL ANDed with everything else.
+	Jql string
+	// OrderBy holds fields, each prefixed by '-' for descending order.
+	OrderBy []string
+}
+
+// JqlQuery returns the query for the filter.
+func (jb *JiraBoss) JqlQuery(f *IssueFilter) string {
+	var notDone, epic, updated, text jql.Clause
+	if f.HideDone {
+		notDone = termNotDone()
+	}
+	if f.Epic != 0 {
+		epic = jql.Eq(CustomFieldEpicLink, jb.Key(f.Epic).String())
+	}
+	if f.UpdatedSince != nil {
+		updated = jql.Term("updated", jql.OpGreaterOrEqual, jql.Date(*f.UpdatedSince))
+	}
+	if f.Text != "" {
+		text = jql.Contains("text", f.Text)
+	}
+	q := jql.Query{
+		Where: jql.And(
+			jb.inProject(),
+			jql.In("issuetype", f.Types...),
+			jql.In("status", f.Statuses...),
+			jql.In("labels", f.Labels...),
+			termAssignees(f.Assignees),
+			epic,
+			updated,
+			text,
+			notDone,
+			jql.Raw(f.Jql),
+		),
+	}
+	for _, o := range f.OrderBy {
+		q.OrderBy = append(q.OrderBy, jql.ParseOrder(o))
+	}
+	return q.String()
+}
+
+func termAssignees(names []string) jql.Clause {
+	var (
+		terms  []jql.Clause
+		others []string
+	)
+	for _, n := range names {
+		switch strings.ToLower(n) {
+		case AssigneeMe:
+			terms = append(terms, jql.Term("assignee", jql.OpEqual, jql.CurrentUser))
+		case AssigneeNone:
+			terms = append(terms, jql.IsEmpty("assignee"))
+		default:
+			others = append(others, n)
+		}
+	}
+	return jql.Or(append(terms, jql.In("assignee", others...))...)
+}
+
+// QueryIssues returns the issues the filter selects, in its order.
+func (jb *JiraBoss) QueryIssues(f *IssueFilter) ([]ResponseIssue, error) {
+	return jb.DoPagedSearch(jb.JqlQuery(f))
+}
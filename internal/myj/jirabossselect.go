@@ -0,0 +1,39 @@
+package myj
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const endpointFilter = "rest/api/2/filter"
+
+// GetFilterJql returns the query of a saved filter.
+func (jb *JiraBoss) GetFilterJql(id int) (string, error) {
+	var resp struct {
+		Name string `json:"name"`
+		Jql  string `json:"jql"`
+	}
+	if err := jb.getJson(endpointFilter+"/"+strconv.Itoa(id), &resp); err != nil {
+		return "", fmt.Errorf("trouble getting filter %d; %w", id, err)
+	}
+	if resp.Jql == "" {
+		return "", fmt.Errorf("filter %d %q has no query", id, resp.Name)
+	}
+	return resp.Jql, nil
+}
+
+// SelectIssues returns the numbers, in order, of the project's
+// issues matching the query.
+func (jb *JiraBoss) SelectIssues(jql string) ([]int, error) {
+	issues, err := jb.DoPagedSearch(jb.jqlSelection(jql))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]int, len(issues))
+	for i := range issues {
+		result[i] = issues[i].MyKey.Num
+	}
+	sort.Ints(result)
+	return result, nil
+}
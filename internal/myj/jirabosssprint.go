@@ -0,0 +1,276 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// The agile API knows about boards and sprints; the v2 API does not.
+// https://developer.atlassian.com/server/jira/platform/rest/v10004/api-group-board/#api-group-board
+// https://developer.atlassian.com/server/jira/platform/rest/v10004/api-group-sprint/#api-group-sprint
+const (
+	endpointAgileBoard   = "rest/agile/1.0/board"
+	endpointAgileSprint  = "rest/agile/1.0/sprint"
+	endpointAgileBacklog = "rest/agile/1.0/backlog/issue"
+	endpointAgileIssue   = "rest/agile/1.0/issue"
+)
+
+const (
+	SprintStateActive = "active"
+	SprintStateFuture = "future"
+	SprintStateClosed = "closed"
+)
+
+// The agile API refuses to move more than this many issues per request.
+const maxIssuesPerSprintMove = 50
+
+type Board struct {
+	Id   int    `json:"id"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+type Sprint struct {
+	Id            int    `json:"id"`
+	Name          string `json:"name,omitempty"`
+	State         string `json:"state,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	CompleteDate  string `json:"completeDate,omitempty"`
+	OriginBoardId int    `json:"originBoardId,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+}
+
+// DateStart returns the sprint's start date, or GoEpicDate if it has none.
+func (s *Sprint) DateStart() utils.Date {
+	return utils.FromTimestampOrEpic(s.StartDate)
+}
+
+// DateEnd returns the sprint's end date, or GoEpicDate if it has none.
+func (s *Sprint) DateEnd() utils.Date {
+	return utils.FromTimestampOrEpic(s.EndDate)
+}
+
+// DayRange returns the sprint's dates as a range, or an error if
+// the sprint hasn't been scheduled.
+func (s *Sprint) DayRange() (*utils.DayRange, error) {
+	start, end := s.DateStart(), s.DateEnd()
+	if !start.IsDefined() || !end.IsDefined() {
+		return nil, fmt.Errorf("sprint %d %q has no dates", s.Id, s.Name)
+	}
+	return utils.MakeDayRangeGentle(start, end)
+}
+
+// responseAgilePage is the envelope the agile API puts around lists.
+type responseAgilePage struct {
+	MaxResults int             `json:"maxResults,omitempty"`
+	StartAt    int             `json:"startAt,omitempty"`
+	IsLast     bool            `json:"isLast,omitempty"`
+	Values     json.RawMessage `json:"values,omitempty"`
+}
+
+// doPagedAgileGet walks the pages of an agile API list, handing each
+// page's values to the given function.
+func (jb *JiraBoss) doPagedAgileGet(
+	path string, query url.Values, f func(json.RawMessage) (int, error)) error {
+	startAt := 0
+	for {
+		query.Set("startAt", strconv.Itoa(startAt))
+		query.Set("maxResults", strconv.Itoa(maxResult))
+		body, err := jb.punchItChewie(
+			http.MethodGet, nil, path+"?"+query.Encode())
+		if err != nil {
+			return err
+		}
+		var page responseAgilePage
+		if err = json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("trouble unmarshaling response; %w", err)
+		}
+		var count int
+		if count, err = f(page.Values); err != nil {
+			return fmt.Errorf("trouble unmarshaling values; %w", err)
+		}
+		startAt += count
+		if page.IsLast || count == 0 || startAt > maxMaxResult {
+			return nil
+		}
+	}
+}
+
+// GetBoards returns the boards associated with the project.
+func (jb *JiraBoss) GetBoards() (result []Board, err error) {
+	query := url.Values{}
+	query.Set("projectKeyOrId", jb.Project())
+	err = jb.doPagedAgileGet(
+		endpointAgileBoard, query, func(raw json.RawMessage) (int, error) {
+			var boards []Board
+			if err := json.Unmarshal(raw, &boards); err != nil {
+				return 0, err
+			}
+			result = append(result, boards...)
+			return len(boards), nil
+		})
+	return
+}
+
+// FindBoard returns the given board id if it's non-zero, else it returns
+// the id of the project's first scrum board (only scrum boards have sprints).
+func (jb *JiraBoss) FindBoard(id int) (int, error) {
+	if id > 0 {
+		return id, nil
+	}
+	boards, err := jb.GetBoards()
+	if err != nil {
+		return 0, err
+	}
+	for _, b := range boards {
+		if b.Type == "scrum" {
+			return b.Id, nil
+		}
+	}
+	return 0, fmt.Errorf(
+		"no scrum board found in project %s; specify a board id", jb.Project())
+}
+
+// GetSprints returns the sprints on the given board in the given states.
+// With no states specified, all sprints are returned.
+func (jb *JiraBoss) GetSprints(
+	board int, states ...string) (result []Sprint, err error) {
+	query := url.Values{}
+	if len(states) > 0 {
+		query.Set("state", strings.Join(states, ","))
+	}
+	err = jb.doPagedAgileGet(
+		endpointAgileBoard+"/"+strconv.Itoa(board)+"/sprint",
+		query, func(raw json.RawMessage) (int, error) {
+			var sprints []Sprint
+			if err := json.Unmarshal(raw, &sprints); err != nil {
+				return 0, err
+			}
+			result = append(result, sprints...)
+			return len(sprints), nil
+		})
+	return
+}
+
+// GetActiveSprint returns the (first) active sprint on the given board.
+func (jb *JiraBoss) GetActiveSprint(board int) (*Sprint, error) {
+	sprints, err := jb.GetSprints(board, SprintStateActive)
+	if err != nil {
+		return nil, err
+	}
+	if len(sprints) == 0 {
+		return nil, fmt.Errorf("board %d has no active sprint", board)
+	}
+	return &sprints[0], nil
+}
+
+// GetSprint returns the sprint with the given id.
+func (jb *JiraBoss) GetSprint(id int) (*Sprint, error) {
+	body, err := jb.punchItChewie(
+		http.MethodGet, nil, endpointAgileSprint+"/"+strconv.Itoa(id))
+	if err != nil {
+		return nil, err
+	}
+	var resp Sprint
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("trouble unmarshaling sprint; %w", err)
+	}
+	return &resp, nil
+}
+
+// GetSprintIssues returns the issues in the given sprint.
+func (jb *JiraBoss) GetSprintIssues(id int) (result []ResponseIssue, err error) {
+	query := url.Values{}
+	query.Set("fields", strings.Join(makeSearchRequest("").Fields, ","))
+	for {
+		query.Set("startAt", strconv.Itoa(len(result)))
+		query.Set("maxResults", strconv.Itoa(maxResult))
+		var body []byte
+		body, err = jb.punchItChewie(
+			http.MethodGet, nil,
+			endpointAgileSprint+"/"+strconv.Itoa(id)+"/issue?"+query.Encode())
+		if err != nil {
+			return nil, err
+		}
+		resp := &ResponseSearch{}
+		if err = json.Unmarshal(body, resp); err != nil {
+			return nil, fmt.Errorf("trouble unmarshaling response; %w", err)
+		}
+		if len(resp.Issues) == 0 {
+			break
+		}
+		for i := range resp.Issues {
+			resp.Issues[i].SetMyKey()
+		}
+		result = append(result, resp.Issues...)
+		if len(result) >= resp.Total || len(result) > maxMaxResult {
+			break
+		}
+	}
+	return
+}
+
+// AddToSprint moves the given issues into the given sprint.
+func (jb *JiraBoss) AddToSprint(sprint int, issues []int) error {
+	return jb.moveIssues(
+		endpointAgileSprint+"/"+strconv.Itoa(sprint)+"/issue", issues)
+}
+
+// RemoveFromSprint moves the given issues out of whatever sprint
+// they are in and back to the backlog.
+func (jb *JiraBoss) RemoveFromSprint(issues []int) error {
+	return jb.moveIssues(endpointAgileBacklog, issues)
+}
+
+func (jb *JiraBoss) moveIssues(path string, issues []int) error {
+	keys := make([]string, len(issues))
+	for i, issue := range issues {
+		keys[i] = jb.Key(issue).String()
+	}
+	return jb.moveKeys(path, keys)
+}
+
+func (jb *JiraBoss) moveKeys(path string, keys []string) error {
+	var req struct {
+		Issues []string `json:"issues"`
+	}
+	for len(keys) > 0 {
+		n := min(len(keys), maxIssuesPerSprintMove)
+		req.Issues = keys[:n]
+		if _, err := jb.punchItChewie(http.MethodPost, &req, path); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// KeepSprintIssues returns a copy of the given map with only those issues
+// that are in the given sprint.  Epics left with no issues are dropped.
+func (jb *JiraBoss) KeepSprintIssues(
+	sprint int, im map[MyKey]IssueList) (map[MyKey]IssueList, error) {
+	issues, err := jb.GetSprintIssues(sprint)
+	if err != nil {
+		return nil, err
+	}
+	inSprint := make(map[MyKey]bool, len(issues))
+	for i := range issues {
+		inSprint[issues[i].MyKey] = true
+	}
+	result := make(map[MyKey]IssueList)
+	for epic, list := range im {
+		for _, issue := range list {
+			if inSprint[issue.MyKey] {
+				result[epic] = append(result[epic], issue)
+			}
+		}
+	}
+	return result, nil
+}
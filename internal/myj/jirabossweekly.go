@@ -0,0 +1,147 @@
+package myj
+
+import (
+	"strings"
+
+	"github.com/monopole/gojira/internal/jql"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// EpicWeek holds what happened to an epic and its stories during
+// some range of days (typically a week).
+type EpicWeek struct {
+	Epic *ResponseIssue
+	// Completed holds stories resolved during the range.
+	Completed IssueList
+	// InProgress holds open stories that are in progress.
+	InProgress IssueList
+	// NewlyBlocked holds open stories that became blocked during the range.
+	NewlyBlocked IssueList
+	// Blockers holds the issues each newly blocked story depends on.
+	Blockers map[MyKey][]MyKey
+	// DateChanges holds changes to the epic's dates during the range.
+	DateChanges []FieldChange
+}
+
+// GetEpicWeeks returns what happened during the range to the given epics,
+// or, if none are given, to the project's epics that aren't done.
+// The result is in epic start date order.
+func (jb *JiraBoss) GetEpicWeeks(
+	epicNums []int, dr *utils.DayRange) ([]*EpicWeek, error) {
+	query := jb.jqlEpicsFiltered(&EpicFilter{HideDone: true})
+	if len(epicNums) > 0 {
+		keys := make([]MyKey, len(epicNums))
+		for i, n := range epicNums {
+			keys[i] = jb.Key(n)
+		}
+		query = jql.And(jb.inProject(), isType(IssueTypeEpic), termKeys(keys)).String()
+	}
+	epics, err := jb.DoPagedSearchWithChangelog(query)
+	if err != nil {
+		return nil, err
+	}
+	weeks := make(map[MyKey]*EpicWeek, len(epics))
+	epicMap := make(map[MyKey]*ResponseIssue, len(epics))
+	keys := make([]MyKey, 0, len(epics))
+	for i := range epics {
+		epic := &epics[i]
+		epicMap[epic.MyKey] = epic
+		keys = append(keys, epic.MyKey)
+		w := &EpicWeek{Epic: epic}
+		for _, c := range epic.FieldChanges(
+			CustomFieldStartDate, CustomFieldTargetCompletionDate) {
+			if dr.Contains(c.When) {
+				w.DateChanges = append(w.DateChanges, c)
+			}
+		}
+		weeks[epic.MyKey] = w
+	}
+
+	var open []ResponseIssue
+	for len(keys) > 0 {
+		// Keep the query a reasonable length.
+		n := min(len(keys), maxResult)
+		found, err := jb.DoPagedSearchWithChangelog(jb.jqlIssuesInEpics(keys[:n]))
+		if err != nil {
+			return nil, err
+		}
+		open = append(open, found...)
+		keys = keys[n:]
+	}
+	linkTypes := jb.DependencyLinkTypes()
+	inwards, err := jb.inwardPhrases(linkTypes)
+	if err != nil {
+		return nil, err
+	}
+	for i := range open {
+		story := &open[i]
+		w, ok := weeks[jb.DetermineEpicLink(story)]
+		if !ok {
+			continue
+		}
+		if story.IsInProgress() {
+			w.InProgress = append(w.InProgress, story)
+		}
+		blockers := story.DependsOn(linkTypes)
+		if len(blockers) > 0 && becameBlocked(story, dr, inwards) {
+			w.NewlyBlocked = append(w.NewlyBlocked, story)
+			if w.Blockers == nil {
+				w.Blockers = make(map[MyKey][]MyKey)
+			}
+			w.Blockers[story.MyKey] = blockers
+		}
+	}
+
+	resolved, err := jb.DoPagedSearch(jb.jqlIssuesResolved(dr))
+	if err != nil {
+		return nil, err
+	}
+	for i := range resolved {
+		story := &resolved[i]
+		if w, ok := weeks[jb.DetermineEpicLink(story)]; ok {
+			w.Completed = append(w.Completed, story)
+		}
+	}
+
+	result := make([]*EpicWeek, 0, len(weeks))
+	for _, k := range GetSortedKeys(epicMap) {
+		result = append(result, weeks[k.MyKey])
+	}
+	return result, nil
+}
+
+// inwardPhrases returns, in lower case, how the changelog describes
+// the inward side of links of the given types, e.g. "is blocked by".
+func (jb *JiraBoss) inwardPhrases(linkTypes []string) ([]string, error) {
+	all, err := jb.GetLinkTypes()
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, t := range all {
+		for _, name := range linkTypes {
+			if strings.EqualFold(t.Name, name) && t.Inward != "" {
+				result = append(result, strings.ToLower(t.Inward))
+			}
+		}
+	}
+	return result, nil
+}
+
+// becameBlocked is true if a dependency link, described in the
+// changelog by one of the inward phrases, was added to the story
+// during the range.
+func becameBlocked(story *ResponseIssue, dr *utils.DayRange, inwards []string) bool {
+	for _, c := range story.FieldChanges(ChangeFieldLink) {
+		if !dr.Contains(c.When) {
+			continue
+		}
+		to := strings.ToLower(c.To)
+		for _, phrase := range inwards {
+			if strings.Contains(to, phrase) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,386 @@
+package myj
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// The journal is an append-only file holding one JournalEntry,
+// as json, per line.  Every write to jira is recorded in it,
+// along with what's needed to undo the write.
+
+// JournalEntry records one write to jira.
+type JournalEntry struct {
+	// Id is unique; the session id plus a sequence number.
+	Id string `json:"id"`
+	// Session identifies one run of gojira.
+	Session string    `json:"session"`
+	Time    time.Time `json:"time"`
+	// Command is the command line that made the write.
+	Command string          `json:"command,omitempty"`
+	Kind    WriteKind       `json:"kind"`
+	Method  string          `json:"method"`
+	Path    string          `json:"path"`
+	Key     string          `json:"key,omitempty"`
+	Request json.RawMessage `json:"request,omitempty"`
+	// Before is the state of things before the write.
+	Before JournalState `json:"before"`
+	// Undoes is the id of the entry this write undid, if any.
+	Undoes string `json:"undoes,omitempty"`
+}
+
+// JournalState is whatever's needed to undo a write;
+// which fields are used depends on the kind of write.
+type JournalState struct {
+	// Fields holds issue fields, by field id.
+	Fields map[string]json.RawMessage `json:"fields,omitempty"`
+	Status string                     `json:"status,omitempty"`
+	// Comment holds the body of a comment.
+	Comment   string     `json:"comment,omitempty"`
+	CommentId string     `json:"commentId,omitempty"`
+	Link      *IssueLink `json:"link,omitempty"`
+	// Sprints holds the sprint of each issue, zero meaning the backlog.
+	Sprints map[string]int `json:"sprints,omitempty"`
+}
+
+func (e *JournalEntry) String() string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "%s %-14s", e.Time.Local().Format("2006-01-02 15:04:05"), e.Kind)
+	if e.Key != "" {
+		b.WriteString(" " + e.Key)
+	}
+	switch e.Kind {
+	case WriteKindFields:
+		var names []string
+		for k := range e.Before.Fields {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		_, _ = fmt.Fprintf(&b, " %v", names)
+	case WriteKindTransition:
+		b.WriteString(" from " + strconv.Quote(e.Before.Status))
+	case WriteKindLinkDelete:
+		if l := e.Before.Link; l != nil {
+			_, _ = fmt.Fprintf(&b, " %s %s %s",
+				l.InwardIssue.Key, strings.ToLower(l.Type.Outward), l.OutwardIssue.Key)
+		}
+	case WriteKindSprintMove:
+		var keys []string
+		for k := range e.Before.Sprints {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteString(" " + strings.Join(keys, " "))
+	case WriteKindUnknown:
+		b.WriteString(" " + e.Method + " " + e.Path)
+	}
+	if e.Undoes != "" {
+		b.WriteString(" (undoing " + e.Undoes + ")")
+	}
+	return b.String()
+}
+
+// DefaultJournalPath returns where the journal lives if not told otherwise.
+func DefaultJournalPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "gojira", "journal.jsonl")
+}
+
+// ReadJournal returns all the entries in the journal, oldest first.
+// A missing journal is empty.
+func ReadJournal(path string) ([]JournalEntry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	var result []JournalEntry
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, 16*1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		if len(strings.TrimSpace(sc.Text())) == 0 {
+			continue
+		}
+		var e JournalEntry
+		if err = json.Unmarshal(sc.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("%s line %d; %w", path, n, err)
+		}
+		result = append(result, e)
+	}
+	return result, sc.Err()
+}
+
+// journalPatterns recognize the paths of the writes gojira makes.
+var journalPatterns = []struct {
+	method string
+	kind   WriteKind
+	re     *regexp.Regexp
+}{
+	{http.MethodPut, WriteKindFields, pathRegexp(endpointIssue, `/(?P<key>[^/]+)`)},
+	{http.MethodPost, WriteKindTransition,
+		pathRegexp(endpointIssue, `/(?P<key>[^/]+)/transitions`)},
+	{http.MethodPost, WriteKindCommentAdd,
+		pathRegexp(endpointIssue, `/(?P<key>[^/]+)/`+pathComment)},
+	{http.MethodPut, WriteKindCommentEdit,
+		pathRegexp(endpointIssue, `/(?P<key>[^/]+)/`+pathComment+`/[0-9]+`)},
+	{http.MethodDelete, WriteKindCommentDelete,
+		pathRegexp(endpointIssue, `/(?P<key>[^/]+)/`+pathComment+`/[0-9]+`)},
+	{http.MethodPost, WriteKindLinkAdd, pathRegexp(endpointIssueLink, ``)},
+	{http.MethodDelete, WriteKindLinkDelete, pathRegexp(endpointIssueLink, `/[0-9]+`)},
+	{http.MethodPost, WriteKindSprintMove,
+		pathRegexp(endpointAgileSprint, `/[0-9]+/issue`)},
+	{http.MethodPost, WriteKindSprintMove, pathRegexp(endpointAgileBacklog, ``)},
+}
+
+// classifyWrite returns the kind of the write, and the key of
+// the issue written if the path holds one.
+func classifyWrite(method, path string) (WriteKind, string) {
+	for _, p := range journalPatterns {
+		m := p.re.FindStringSubmatch(path)
+		if p.method != method || m == nil {
+			continue
+		}
+		if i := p.re.SubexpIndex("key"); i >= 0 {
+			return p.kind, m[i]
+		}
+		return p.kind, ""
+	}
+	return WriteKindUnknown, ""
+}
+
+func pathRegexp(endpoint, rest string) *regexp.Regexp {
+	return regexp.MustCompile(`^` + regexp.QuoteMeta(endpoint) + rest + `$`)
+}
+
+// isWrite is true if the request changes something in jira.
+func isWrite(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return false
+	case http.MethodPost:
+		// A search is a read, posted to allow a long query.
+		return path != endpointSearch
+	}
+	return true
+}
+
+// prepareEntry makes a journal entry for the write, fetching
+// what the write is about to change.
+func (jb *JiraBoss) prepareEntry(method, path string, body []byte) (*JournalEntry, error) {
+	if jb.session == "" {
+		jb.session = time.Now().Format("20060102-150405") +
+			"-" + strconv.Itoa(os.Getpid())
+	}
+	jb.journalSeq++
+	e := &JournalEntry{
+		Id:      jb.session + "." + strconv.Itoa(jb.journalSeq),
+		Session: jb.session,
+		Time:    time.Now(),
+		Command: strings.Join(redactArgs(os.Args[1:]), " "),
+		Method:  method,
+		Path:    path,
+		Undoes:  jb.undoing,
+	}
+	if string(body) != "null" {
+		e.Request = body
+	}
+	e.Kind, e.Key = classifyWrite(method, path)
+	var err error
+	switch e.Kind {
+	case WriteKindFields:
+		e.Before.Fields, err = jb.fetchFields(e.Key, body)
+	case WriteKindTransition:
+		var ri *ResponseIssue
+		if ri, err = jb.fetchIssue(e.Key, "status"); err == nil {
+			e.Before.Status = ri.StatusRaw()
+		}
+	case WriteKindCommentEdit, WriteKindCommentDelete:
+		var c Comment
+		if err = jb.getJson(path, &c); err == nil {
+			e.Before.Comment = c.Body
+		}
+	case WriteKindLinkDelete:
+		e.Before.Link = &IssueLink{}
+		err = jb.getJson(path, e.Before.Link)
+	case WriteKindSprintMove:
+		e.Before.Sprints, err = jb.fetchSprints(body)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("trouble recording %s %s for undo; %w", method, path, err)
+	}
+	return e, nil
+}
+
+// recordEntry completes the entry with the response to the write,
+// and appends it to the journal.  The write has happened, so
+// trouble here is reported rather than returned.
+func (jb *JiraBoss) recordEntry(e *JournalEntry, resp []byte) {
+	if e.Kind == WriteKindCommentAdd {
+		var c Comment
+		if err := json.Unmarshal(resp, &c); err == nil {
+			e.Before.CommentId = c.Id
+		}
+	}
+	if err := appendToJournal(jb.args.JournalPath, e); err != nil {
+		utils.DoErrF("Unable to journal %s %s, so it can't be undone; %v\n",
+			e.Method, e.Path, err)
+	}
+}
+
+// secretFlags take values that mustn't be written to the journal.
+var secretFlags = []string{"-t", "--token"}
+
+// redactArgs returns the command line args with secret flag values
+// replaced, in any of the forms "-t x", "--token x", "--token=x" or "-tx".
+func redactArgs(args []string) []string {
+	const redacted = "REDACTED"
+	result := make([]string, len(args))
+	copy(result, args)
+	for i := 0; i < len(result); i++ {
+		for _, f := range secretFlags {
+			switch {
+			case result[i] == f:
+				if i+1 < len(result) {
+					i++
+					result[i] = redacted
+				}
+			case strings.HasPrefix(result[i], f+"="):
+				result[i] = f + "=" + redacted
+			case len(f) == 2 && strings.HasPrefix(result[i], f):
+				result[i] = f + redacted
+			default:
+				continue
+			}
+			break
+		}
+	}
+	return result
+}
+
+func appendToJournal(path string, e *JournalEntry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (jb *JiraBoss) getJson(path string, v any) error {
+	body, err := jb.punchItChewie(http.MethodGet, nil, path)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("trouble unmarshaling %s; %w", path, err)
+	}
+	return nil
+}
+
+func (jb *JiraBoss) fetchIssue(key string, fields ...string) (*ResponseIssue, error) {
+	var ri ResponseIssue
+	err := jb.getJson(
+		endpointIssue+"/"+key+"?fields="+strings.Join(fields, ","), &ri)
+	return &ri, err
+}
+
+// fetchFields returns the current values of the fields in the request.
+func (jb *JiraBoss) fetchFields(key string, req []byte) (map[string]json.RawMessage, error) {
+	var r struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(req, &r); err != nil {
+		return nil, err
+	}
+	var names []string
+	for k := range r.Fields {
+		names = append(names, k)
+	}
+	var resp struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := jb.getJson(
+		endpointIssue+"/"+key+"?fields="+strings.Join(names, ","), &resp); err != nil {
+		return nil, err
+	}
+	result := make(map[string]json.RawMessage, len(names))
+	for _, name := range names {
+		result[name] = writableValue(resp.Fields[name])
+	}
+	return result, nil
+}
+
+// writableValue converts a field value as read to one that can be
+// written back.  Values like users are read as objects with many
+// fields, but are written by name alone.
+func writableValue(v json.RawMessage) json.RawMessage {
+	if len(v) == 0 {
+		return json.RawMessage("null")
+	}
+	var obj map[string]any
+	if json.Unmarshal(v, &obj) != nil {
+		return v
+	}
+	if name, ok := obj["name"]; ok {
+		data, err := json.Marshal(map[string]any{"name": name})
+		if err == nil {
+			return data
+		}
+	}
+	return v
+}
+
+// fetchSprints returns the sprint of each issue in the request.
+func (jb *JiraBoss) fetchSprints(req []byte) (map[string]int, error) {
+	var r struct {
+		Issues []string `json:"issues"`
+	}
+	if err := json.Unmarshal(req, &r); err != nil {
+		return nil, err
+	}
+	result := make(map[string]int, len(r.Issues))
+	for _, key := range r.Issues {
+		var resp struct {
+			Fields struct {
+				Sprint *Sprint `json:"sprint"`
+			} `json:"fields"`
+		}
+		if err := jb.getJson(
+			endpointAgileIssue+"/"+key+"?fields=sprint", &resp); err != nil {
+			return nil, err
+		}
+		if resp.Fields.Sprint != nil {
+			result[key] = resp.Fields.Sprint.Id
+		} else {
+			result[key] = 0
+		}
+	}
+	return result, nil
+}
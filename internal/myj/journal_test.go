@@ -0,0 +1,96 @@
+package myj
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyWrite(t *testing.T) {
+	type testCase struct {
+		method string
+		path   string
+		write  bool
+		kind   WriteKind
+		key    string
+	}
+	tests := map[string]testCase{
+		"fields": {
+			http.MethodPut, "rest/api/2/issue/BUDS-1", true, WriteKindFields, "BUDS-1"},
+		"transition": {
+			http.MethodPost, "rest/api/2/issue/BUDS-1/transitions", true,
+			WriteKindTransition, "BUDS-1"},
+		"commentAdd": {
+			http.MethodPost, "rest/api/2/issue/BUDS-1/comment", true,
+			WriteKindCommentAdd, "BUDS-1"},
+		"commentEdit": {
+			http.MethodPut, "rest/api/2/issue/BUDS-1/comment/123", true,
+			WriteKindCommentEdit, "BUDS-1"},
+		"commentDelete": {
+			http.MethodDelete, "rest/api/2/issue/BUDS-1/comment/123", true,
+			WriteKindCommentDelete, "BUDS-1"},
+		"linkAdd": {
+			http.MethodPost, "rest/api/2/issueLink", true, WriteKindLinkAdd, ""},
+		"linkDelete": {
+			http.MethodDelete, "rest/api/2/issueLink/77", true, WriteKindLinkDelete, ""},
+		"toSprint": {
+			http.MethodPost, "rest/agile/1.0/sprint/42/issue", true,
+			WriteKindSprintMove, ""},
+		"toBacklog": {
+			http.MethodPost, "rest/agile/1.0/backlog/issue", true,
+			WriteKindSprintMove, ""},
+		"create": {
+			http.MethodPost, "rest/api/2/issue", true, WriteKindUnknown, ""},
+		"search": {
+			http.MethodPost, "rest/api/2/search", false, WriteKindUnknown, ""},
+		"get": {
+			http.MethodGet, "rest/api/2/issue/BUDS-1", false, WriteKindUnknown, ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.write, isWrite(tc.method, tc.path))
+			if !tc.write {
+				return
+			}
+			kind, key := classifyWrite(tc.method, tc.path)
+			assert.Equal(t, tc.kind, kind)
+			assert.Equal(t, tc.key, key)
+		})
+	}
+}
+
+func TestWritableValue(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected string
+	}
+	tests := map[string]testCase{
+		"missing": {"", "null"},
+		"null":    {"null", "null"},
+		"string":  {`"2025-03-10"`, `"2025-03-10"`},
+		"list":    {`["a","b"]`, `["a","b"]`},
+		"user": {
+			`{"name":"jdoe","displayName":"J. Doe","emailAddress":"jdoe@x.com"}`,
+			`{"name":"jdoe"}`},
+		"noName": {`{"value":"High","id":"3"}`, `{"value":"High","id":"3"}`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.JSONEq(t, tc.expected,
+				string(writableValue(json.RawMessage(tc.in))))
+		})
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"label", "-t", "REDACTED", "1", "--token", "REDACTED",
+			"--token=REDACTED", "-tREDACTED", "-p", "BUDS"},
+		redactArgs([]string{"label", "-t", "s1", "1", "--token", "s2",
+			"--token=s3", "-ts4", "-p", "BUDS"}))
+	// A dangling flag has nothing to redact.
+	assert.Equal(t, []string{"undo", "--token"},
+		redactArgs([]string{"undo", "--token"}))
+}
@@ -0,0 +1,177 @@
+package myj
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/monopole/gojira/internal/utils"
+)
+
+//go:generate go run github.com/dmarkham/enumer -linecomment -json -type=LintRule
+type LintRule int
+
+// The rule names are stable; scripts may depend on them.
+const (
+	LintRuleUnknown          LintRule = iota
+	LintRuleEpicName                  // epic-name
+	LintRuleMissingDates              // missing-dates
+	LintRuleEndBeforeStart            // end-before-start
+	LintRuleWeekendDate               // weekend-date
+	LintRuleOutsideEpic               // outside-epic
+	LintRuleOrphanStory               // orphan-story
+	LintRuleDoneEpicOpenWork          // done-epic-open-work
+)
+
+//go:generate go run github.com/dmarkham/enumer -linecomment -json -type=LintSeverity
+type LintSeverity int
+
+const (
+	LintSeverityInfo    LintSeverity = iota // info
+	LintSeverityWarning                     // warning
+	LintSeverityError                       // error
+)
+
+// lintSeverities holds the severity of each rule.
+var lintSeverities = map[LintRule]LintSeverity{
+	LintRuleEpicName:         LintSeverityWarning,
+	LintRuleMissingDates:     LintSeverityError,
+	LintRuleEndBeforeStart:   LintSeverityError,
+	LintRuleWeekendDate:      LintSeverityInfo,
+	LintRuleOutsideEpic:      LintSeverityWarning,
+	LintRuleOrphanStory:      LintSeverityWarning,
+	LintRuleDoneEpicOpenWork: LintSeverityError,
+}
+
+// LintFinding is one rule violation by one issue.
+type LintFinding struct {
+	Rule     LintRule     `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	// KeyName is Key as jira writes it, e.g. "PROJ-12", for json.
+	Key     MyKey  `json:"-"`
+	KeyName string `json:"key"`
+	Message string `json:"message"`
+	// Fixable is true if FixLint can repair the finding.
+	Fixable bool `json:"fixable"`
+}
+
+func makeFinding(rule LintRule, k MyKey, f string, args ...any) LintFinding {
+	return LintFinding{
+		Rule:     rule,
+		Severity: lintSeverities[rule],
+		Key:      k,
+		KeyName:  k.String(),
+		Message:  fmt.Sprintf(f, args...),
+		Fixable:  rule == LintRuleEpicName || rule == LintRuleWeekendDate,
+	}
+}
+
+// Lint checks the given epics, and the open stories in them, for
+// data problems.  The stories are keyed by epic, as returned by
+// GetIssuesGroupedByEpic.  Findings are sorted by issue key, then rule.
+func Lint(epics map[MyKey]*ResponseIssue, stories map[MyKey]IssueList) []LintFinding {
+	var result []LintFinding
+	add := func(rule LintRule, k MyKey, f string, args ...any) {
+		result = append(result, makeFinding(rule, k, f, args...))
+	}
+	lintDates := func(ri *ResponseIssue, required bool) (*utils.DayRange, bool) {
+		start, end := ri.DateStart(), ri.DateEnd()
+		if !start.IsDefined() || !end.IsDefined() {
+			if required {
+				add(LintRuleMissingDates, ri.MyKey, "missing start or end date")
+			}
+			return nil, false
+		}
+		if end.Before(start) {
+			add(LintRuleEndBeforeStart, ri.MyKey, "ends %s before it starts %s", end, start)
+			return nil, false
+		}
+		if start.IsWeekend() {
+			add(LintRuleWeekendDate, ri.MyKey, "starts on a %s (%s)", start.Weekday(), start)
+		}
+		if end.IsWeekend() {
+			add(LintRuleWeekendDate, ri.MyKey, "ends on a %s (%s)", end.Weekday(), end)
+		}
+		dr, err := utils.MakeDayRangeGentle(start, end)
+		return dr, err == nil
+	}
+	for k, epic := range epics {
+		if k.Num >= UnknownEpicBase {
+			for _, s := range stories[k] {
+				add(LintRuleOrphanStory, s.MyKey, "story has no epic")
+				lintDates(s, false)
+			}
+			continue
+		}
+		if epic.Fields.CustomEpicName != epic.Fields.Summary {
+			add(LintRuleEpicName, k, "epic name %q differs from summary %q",
+				epic.Fields.CustomEpicName, epic.Fields.Summary)
+		}
+		epicDr, ok := lintDates(epic, !epic.SeemsDone())
+		var open []string
+		for _, s := range stories[k] {
+			if !s.SeemsDone() {
+				open = append(open, s.MyKey.String())
+			}
+			if dr, ok2 := lintDates(s, false); ok && ok2 && !epicDr.ContainsRange(dr) {
+				add(LintRuleOutsideEpic, s.MyKey, "dates %s fall outside epic %s dates %s",
+					dr.PrettyRange(), k, epicDr.PrettyRange())
+			}
+		}
+		if epic.SeemsDone() && len(open) > 0 {
+			add(LintRuleDoneEpicOpenWork, k, "epic is %s but has %d open stories %v",
+				epic.StatusRaw(), len(open), open)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i].Key, result[j].Key
+		if a != b {
+			return a.Less(b)
+		}
+		return result[i].Rule < result[j].Rule
+	})
+	return result
+}
+
+// FixLint repairs the fixable findings; it copies summaries to epic names
+// and slides weekend dates onto weekdays.  Issues are looked up in the
+// given epics and stories.
+func (jb *JiraBoss) FixLint(
+	findings []LintFinding, epics map[MyKey]*ResponseIssue,
+	stories map[MyKey]IssueList) error {
+	byKey := make(map[MyKey]*ResponseIssue)
+	for k, epic := range epics {
+		byKey[k] = epic
+	}
+	for _, list := range stories {
+		for _, s := range list {
+			byKey[s.MyKey] = s
+		}
+	}
+	nodes := make(map[MyKey]*Node)
+	for _, f := range findings {
+		switch f.Rule {
+		case LintRuleEpicName:
+			if err := jb.FixEpicName(f.Key.Num); err != nil {
+				return err
+			}
+		case LintRuleWeekendDate:
+			ri, ok := byKey[f.Key]
+			if !ok {
+				return fmt.Errorf("cannot find %s", f.Key)
+			}
+			n := MakeNode(ri)
+			n.dateStart = n.dateStart.SlideOverWeekend()
+			n.dateEnd = n.dateEnd.SlideBeforeWeekend()
+			if n.dateEnd.Before(n.dateStart) {
+				// The issue spanned only a weekend.
+				n.dateEnd = n.dateStart
+			}
+			nodes[f.Key] = n
+		default:
+		}
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+	return jb.WriteDates(true, nodes)
+}
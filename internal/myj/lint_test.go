@@ -0,0 +1,117 @@
+package myj
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// lintIssue makes an open issue with matching summary and epic name,
+// and the given dates, which may be empty.
+func lintIssue(num int, start, end string) *ResponseIssue {
+	ri := &ResponseIssue{MyKey: MyKey{Proj: "LINT", Num: num}}
+	ri.Fields.Summary = "tidy up"
+	ri.Fields.CustomEpicName = "tidy up"
+	ri.Fields.CustomStartDate = start
+	ri.Fields.CustomTargetCompletionDate = end
+	ri.Fields.Status.Name = IssueStatusBacklog.String()
+	return ri
+}
+
+func TestLint(t *testing.T) {
+	type testCase struct {
+		epic          *ResponseIssue
+		stories       IssueList
+		expectKey     int
+		expectRule    LintRule
+		expectSev     LintSeverity
+		expectFixable bool
+	}
+	// March 2nd, 2026 is a Monday.
+	tests := map[string]testCase{
+		"epic name": {
+			epic: func() *ResponseIssue {
+				ri := lintIssue(1, "2026-03-02", "2026-03-13")
+				ri.Fields.CustomEpicName = "tidy"
+				return ri
+			}(),
+			expectKey:     1,
+			expectRule:    LintRuleEpicName,
+			expectSev:     LintSeverityWarning,
+			expectFixable: true,
+		},
+		"missing dates": {
+			epic:       lintIssue(1, "", "2026-03-13"),
+			expectKey:  1,
+			expectRule: LintRuleMissingDates,
+			expectSev:  LintSeverityError,
+		},
+		"end before start": {
+			epic:       lintIssue(1, "2026-03-13", "2026-03-02"),
+			expectKey:  1,
+			expectRule: LintRuleEndBeforeStart,
+			expectSev:  LintSeverityError,
+		},
+		"weekend date": {
+			epic:          lintIssue(1, "2026-03-07", "2026-03-13"),
+			expectKey:     1,
+			expectRule:    LintRuleWeekendDate,
+			expectSev:     LintSeverityInfo,
+			expectFixable: true,
+		},
+		"outside epic": {
+			epic:       lintIssue(1, "2026-03-02", "2026-03-13"),
+			stories:    IssueList{lintIssue(2, "2026-03-09", "2026-03-20")},
+			expectKey:  2,
+			expectRule: LintRuleOutsideEpic,
+			expectSev:  LintSeverityWarning,
+		},
+		"orphan story": {
+			epic:       makePlaceHolderEpic(UnknownEpicBase, "LINT"),
+			stories:    IssueList{lintIssue(2, "", "")},
+			expectKey:  2,
+			expectRule: LintRuleOrphanStory,
+			expectSev:  LintSeverityWarning,
+		},
+		"done epic open work": {
+			epic: func() *ResponseIssue {
+				ri := lintIssue(1, "2026-03-02", "2026-03-13")
+				ri.Fields.Status.Name = IssueStatusDone.String()
+				return ri
+			}(),
+			stories:    IssueList{lintIssue(2, "", "")},
+			expectKey:  1,
+			expectRule: LintRuleDoneEpicOpenWork,
+			expectSev:  LintSeverityError,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			epics := map[MyKey]*ResponseIssue{tc.epic.MyKey: tc.epic}
+			stories := map[MyKey]IssueList{tc.epic.MyKey: tc.stories}
+			findings := Lint(epics, stories)
+			if !assert.Len(t, findings, 1) {
+				return
+			}
+			f := findings[0]
+			assert.Equal(t, MyKey{Proj: "LINT", Num: tc.expectKey}, f.Key)
+			assert.Equal(t, tc.expectRule, f.Rule)
+			assert.Equal(t, tc.expectSev, f.Severity)
+			assert.Equal(t, tc.expectFixable, f.Fixable)
+		})
+	}
+}
+
+func TestLintFindingJson(t *testing.T) {
+	f := makeFinding(LintRuleOrphanStory, MyKey{Proj: "LINT", Num: 12}, "story has no epic")
+	b, err := json.Marshal(f)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+  "rule": "orphan-story",
+  "severity": "warning",
+  "key": "LINT-12",
+  "message": "story has no epic",
+  "fixable": false
+}`, string(b))
+}
@@ -0,0 +1,120 @@
+// Code generated by "enumer -linecomment -json -type=LintRule"; DO NOT EDIT.
+
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _LintRuleName = "LintRuleUnknownepic-namemissing-datesend-before-startweekend-dateoutside-epicorphan-storydone-epic-open-work"
+
+var _LintRuleIndex = [...]uint8{0, 15, 24, 37, 53, 65, 77, 89, 108}
+
+const _LintRuleLowerName = "lintruleunknownepic-namemissing-datesend-before-startweekend-dateoutside-epicorphan-storydone-epic-open-work"
+
+func (i LintRule) String() string {
+	if i < 0 || i >= LintRule(len(_LintRuleIndex)-1) {
+		return fmt.Sprintf("LintRule(%d)", i)
+	}
+	return _LintRuleName[_LintRuleIndex[i]:_LintRuleIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _LintRuleNoOp() {
+	var x [1]struct{}
+	_ = x[LintRuleUnknown-(0)]
+	_ = x[LintRuleEpicName-(1)]
+	_ = x[LintRuleMissingDates-(2)]
+	_ = x[LintRuleEndBeforeStart-(3)]
+	_ = x[LintRuleWeekendDate-(4)]
+	_ = x[LintRuleOutsideEpic-(5)]
+	_ = x[LintRuleOrphanStory-(6)]
+	_ = x[LintRuleDoneEpicOpenWork-(7)]
+}
+
+var _LintRuleValues = []LintRule{LintRuleUnknown, LintRuleEpicName, LintRuleMissingDates, LintRuleEndBeforeStart, LintRuleWeekendDate, LintRuleOutsideEpic, LintRuleOrphanStory, LintRuleDoneEpicOpenWork}
+
+var _LintRuleNameToValueMap = map[string]LintRule{
+	_LintRuleName[0:15]:        LintRuleUnknown,
+	_LintRuleLowerName[0:15]:   LintRuleUnknown,
+	_LintRuleName[15:24]:       LintRuleEpicName,
+	_LintRuleLowerName[15:24]:  LintRuleEpicName,
+	_LintRuleName[24:37]:       LintRuleMissingDates,
+	_LintRuleLowerName[24:37]:  LintRuleMissingDates,
+	_LintRuleName[37:53]:       LintRuleEndBeforeStart,
+	_LintRuleLowerName[37:53]:  LintRuleEndBeforeStart,
+	_LintRuleName[53:65]:       LintRuleWeekendDate,
+	_LintRuleLowerName[53:65]:  LintRuleWeekendDate,
+	_LintRuleName[65:77]:       LintRuleOutsideEpic,
+	_LintRuleLowerName[65:77]:  LintRuleOutsideEpic,
+	_LintRuleName[77:89]:       LintRuleOrphanStory,
+	_LintRuleLowerName[77:89]:  LintRuleOrphanStory,
+	_LintRuleName[89:108]:      LintRuleDoneEpicOpenWork,
+	_LintRuleLowerName[89:108]: LintRuleDoneEpicOpenWork,
+}
+
+var _LintRuleNames = []string{
+	_LintRuleName[0:15],
+	_LintRuleName[15:24],
+	_LintRuleName[24:37],
+	_LintRuleName[37:53],
+	_LintRuleName[53:65],
+	_LintRuleName[65:77],
+	_LintRuleName[77:89],
+	_LintRuleName[89:108],
+}
+
+// LintRuleString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func LintRuleString(s string) (LintRule, error) {
+	if val, ok := _LintRuleNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _LintRuleNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to LintRule values", s)
+}
+
+// LintRuleValues returns all values of the enum
+func LintRuleValues() []LintRule {
+	return _LintRuleValues
+}
+
+// LintRuleStrings returns a slice of all String values of the enum
+func LintRuleStrings() []string {
+	strs := make([]string, len(_LintRuleNames))
+	copy(strs, _LintRuleNames)
+	return strs
+}
+
+// IsALintRule returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i LintRule) IsALintRule() bool {
+	for _, v := range _LintRuleValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for LintRule
+func (i LintRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for LintRule
+func (i *LintRule) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("LintRule should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = LintRuleString(s)
+	return err
+}
@@ -0,0 +1,100 @@
+// Code generated by "enumer -linecomment -json -type=LintSeverity"; DO NOT EDIT.
+
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _LintSeverityName = "infowarningerror"
+
+var _LintSeverityIndex = [...]uint8{0, 4, 11, 16}
+
+const _LintSeverityLowerName = "infowarningerror"
+
+func (i LintSeverity) String() string {
+	if i < 0 || i >= LintSeverity(len(_LintSeverityIndex)-1) {
+		return fmt.Sprintf("LintSeverity(%d)", i)
+	}
+	return _LintSeverityName[_LintSeverityIndex[i]:_LintSeverityIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _LintSeverityNoOp() {
+	var x [1]struct{}
+	_ = x[LintSeverityInfo-(0)]
+	_ = x[LintSeverityWarning-(1)]
+	_ = x[LintSeverityError-(2)]
+}
+
+var _LintSeverityValues = []LintSeverity{LintSeverityInfo, LintSeverityWarning, LintSeverityError}
+
+var _LintSeverityNameToValueMap = map[string]LintSeverity{
+	_LintSeverityName[0:4]:        LintSeverityInfo,
+	_LintSeverityLowerName[0:4]:   LintSeverityInfo,
+	_LintSeverityName[4:11]:       LintSeverityWarning,
+	_LintSeverityLowerName[4:11]:  LintSeverityWarning,
+	_LintSeverityName[11:16]:      LintSeverityError,
+	_LintSeverityLowerName[11:16]: LintSeverityError,
+}
+
+var _LintSeverityNames = []string{
+	_LintSeverityName[0:4],
+	_LintSeverityName[4:11],
+	_LintSeverityName[11:16],
+}
+
+// LintSeverityString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func LintSeverityString(s string) (LintSeverity, error) {
+	if val, ok := _LintSeverityNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _LintSeverityNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to LintSeverity values", s)
+}
+
+// LintSeverityValues returns all values of the enum
+func LintSeverityValues() []LintSeverity {
+	return _LintSeverityValues
+}
+
+// LintSeverityStrings returns a slice of all String values of the enum
+func LintSeverityStrings() []string {
+	strs := make([]string, len(_LintSeverityNames))
+	copy(strs, _LintSeverityNames)
+	return strs
+}
+
+// IsALintSeverity returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i LintSeverity) IsALintSeverity() bool {
+	for _, v := range _LintSeverityValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for LintSeverity
+func (i LintSeverity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for LintSeverity
+func (i *LintSeverity) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("LintSeverity should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = LintSeverityString(s)
+	return err
+}
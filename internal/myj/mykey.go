@@ -18,6 +18,19 @@ func (mk MyKey) String() string {
 	return mk.Proj + "-" + strconv.Itoa(mk.Num)
 }
 
+// Less orders keys by project, then number.
+func (mk MyKey) Less(other MyKey) bool {
+	if mk.Proj == other.Proj {
+		return mk.Num < other.Num
+	}
+	return mk.Proj < other.Proj
+}
+
+// Id is the key as a name that's safe to use in mermaid and plantuml.
+func (mk MyKey) Id() string {
+	return mk.Proj + "_" + strconv.Itoa(mk.Num)
+}
+
 func ParseMyKey(k string) (result MyKey) {
 	parts := strings.Split(k, "-")
 	if len(parts) != 2 {
@@ -49,10 +62,7 @@ func (kl KeyList) Less(i, j int) bool {
 }
 
 func (kl KeyList) Less2(i, j int) bool {
-	if kl[i].Proj == kl[j].Proj {
-		return kl[i].Num < kl[j].Num
-	}
-	return kl[i].Proj < kl[j].Proj
+	return kl[i].MyKey.Less(kl[j].MyKey)
 }
 
 func (kl KeyList) Swap(i, j int) {
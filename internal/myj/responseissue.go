@@ -37,6 +37,8 @@ type ResponseIssue struct {
 	Id     string         `json:"id,omitempty"`
 	Key    string         `json:"key,omitempty"`
 	MyKey  MyKey          `json:"myKey,omitempty"`
+	// Changelog is only present if asked for.
+	Changelog *Changelog `json:"changelog,omitempty"`
 }
 
 type basicEpicFields struct {
@@ -63,11 +65,14 @@ type MiscIssueFields struct {
 	//Updated     string            `json:"updated,omitempty"`
 	Labels     []string    `json:"labels,omitempty"`
 	IssueLinks []IssueLink `json:"issueLinks,omitempty"`
+	// ResolutionDate and Created are timestamps.
+	ResolutionDate string `json:"resolutiondate,omitempty"`
+	Created        string `json:"created,omitempty"`
 }
 
 type IssueLink struct {
 	Id           string          `json:"id"`
-	Type         IssueTypeR      `json:"type"`
+	Type         LinkType        `json:"type"`
 	InwardIssue  IssueIdentifier `json:"inwardIssue"`
 	OutwardIssue IssueIdentifier `json:"outwardIssue"`
 }
@@ -106,7 +111,8 @@ type ResolutionDetails struct {
 }
 
 type StatusDetails struct {
-	Name string `json:"name,omitempty"`
+	Name           string         `json:"name,omitempty"`
+	StatusCategory StatusCategory `json:"statusCategory,omitempty"`
 }
 
 // MySummary returns the issue's summary, tacking on the
@@ -150,7 +156,7 @@ func (ri *ResponseIssue) SpewParsable(w io.Writer, brief bool, depth int) {
 		f := fmt.Sprintf("%%-%ds", fieldSize)
 		_, _ = fmt.Fprintf(w, f, ri.Key)
 	}
-	typ := ri.Type().String()
+	typ := ri.TypeRaw()
 	if utils.Debug {
 		str, ok := ri.Fields.CustomEpicLink.(string)
 		if ok && str != "" {
@@ -163,7 +169,7 @@ func (ri *ResponseIssue) SpewParsable(w io.Writer, brief bool, depth int) {
 
 	// e.g. Done, Backlog, In Progress
 	_, _ = fmt.Fprintf(
-		w, "%-16s ", "("+utils.Ellipsis(ri.Status().String(), 14)+")")
+		w, "%-16s ", "("+utils.Ellipsis(ri.StatusRaw(), 14)+")")
 
 	d1 := ri.DateStart()
 	d2 := ri.DateEnd()
@@ -180,30 +186,10 @@ func (ri *ResponseIssue) SpewParsable(w io.Writer, brief bool, depth int) {
 	if brief {
 		return
 	}
-	var blocks []MyKey
-	var blockedBy []MyKey
-	for _, link := range ri.Fields.IssueLinks {
-		if link.Type.Name == LinkTypeBlocks {
-			if link.InwardIssue.Key != "" {
-				blockedBy = append(blockedBy, ParseMyKey(link.InwardIssue.Key))
-			}
-			if link.OutwardIssue.Key != "" {
-				blocks = append(blocks, ParseMyKey(link.OutwardIssue.Key))
-			}
-		}
-	}
-	if len(blockedBy) > 0 {
+	for _, g := range ri.LinkGroups() {
 		doIndent(w, depth+1)
-		_, _ = fmt.Fprintln(w, "is blocked by")
-		for _, k := range blockedBy {
-			doIndent(w, depth+2)
-			_, _ = fmt.Fprintln(w, k)
-		}
-	}
-	if len(blocks) > 0 {
-		doIndent(w, depth+1)
-		_, _ = fmt.Fprintln(w, "blocks")
-		for _, k := range blocks {
+		_, _ = fmt.Fprintln(w, g.Label)
+		for _, k := range g.Keys {
 			doIndent(w, depth+2)
 			_, _ = fmt.Fprintln(w, k)
 		}
@@ -237,6 +223,31 @@ func (ri *ResponseIssue) Status() IssueStatus {
 	return IssueStatusUnknown
 }
 
+// StatusCategory returns the category key of the issue's status, as
+// reported by jira, falling back to the category of well-known statuses.
+func (ri *ResponseIssue) StatusCategory() string {
+	if k := ri.Fields.Status.StatusCategory.Key; k != "" {
+		return k
+	}
+	return knownStatusCategory(ri.Status())
+}
+
+// SeemsDone is true if the issue needs no more work.
+func (ri *ResponseIssue) SeemsDone() bool {
+	return ri.StatusCategory() == StatusCategoryDone
+}
+
+// IsInProgress is true if work on the issue has started but isn't done.
+func (ri *ResponseIssue) IsInProgress() bool {
+	return ri.StatusCategory() == StatusCategoryInProgress
+}
+
+// IsLate is true if the issue's end date has passed but it's not done.
+func (ri *ResponseIssue) IsLate(today utils.Date) bool {
+	end := ri.DateEnd()
+	return end.IsDefined() && today.After(end) && !ri.SeemsDone()
+}
+
 func (ri *ResponseIssue) StatusRaw() string {
 	return ri.Fields.Status.Name
 }
@@ -249,22 +260,19 @@ func (ri *ResponseIssue) Type() IssueType {
 	return IssueTypeUnknown
 }
 
-func (ri *ResponseIssue) IsEpic() bool {
-	return ri.Type() == IssueTypeEpic
+func (ri *ResponseIssue) TypeRaw() string {
+	return ri.Fields.IssueType.Name
 }
 
-// IsOkayUnderEpic kinda makes sense?  Not sure if we want this.
-// Maybe we merely demand that it's not an epic.
-func (ri *ResponseIssue) IsOkayUnderEpic() bool {
-	t := ri.Type()
-	return t == IssueTypeBug ||
-		t == IssueTypeTask ||
-		t == IssueTypeStory ||
-		t == IssueTypeSubTask
+// DateResolved returns the day the issue was resolved,
+// or GoEpicDate if it hasn't been.
+func (ri *ResponseIssue) DateResolved() utils.Date {
+	return utils.FromTimestampOrEpic(ri.Fields.ResolutionDate)
 }
 
-func (ri *ResponseIssue) TypeRaw() string {
-	return ri.Fields.IssueType.Name
+// DateCreated returns the day the issue was created.
+func (ri *ResponseIssue) DateCreated() utils.Date {
+	return utils.FromTimestampOrEpic(ri.Fields.Created)
 }
 
 func (ri *ResponseIssue) DateStart() utils.Date {
@@ -32,6 +32,12 @@ func makeSearchRequest(jql string) RequestSearch {
 			// resolution is a struct describing the conditions of resolution.
 			"resolution",
 
+			// resolutiondate is the timestamp of resolution, if resolved.
+			"resolutiondate",
+
+			// created is the timestamp of creation.
+			"created",
+
 			// status is ?
 			"status",
 
@@ -78,6 +84,9 @@ func makeSearchRequest(jql string) RequestSearch {
 
 			// epicLink is the one epic with which this issue is associated
 			"epicLink",
+
+			// issuelinks holds links to other issues, e.g. blockers.
+			"issuelinks",
 		},
 		Expand: []string{"renderedFields", "names"},
 	}
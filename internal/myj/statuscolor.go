@@ -8,42 +8,37 @@ const (
 	ColorKindUnknown ColorKind = iota
 	ColorKindDot
 	ColorKindTerminal
+	ColorKindSvg
 )
 
-func StatusColor(status IssueStatus, kind ColorKind) utils.ColorString {
-	switch status {
-	case IssueStatusDone, IssueStatusClosed:
+// StatusColor returns the color of the issue's status.  Colors follow
+// the status category, so any project's statuses get sensible colors.
+func StatusColor(ri *ResponseIssue, kind ColorKind) utils.ColorString {
+	switch {
+	case ri.SeemsDone():
 		switch kind {
 		case ColorKindDot:
 			return "lightgreen"
+		case ColorKindSvg:
+			return "#90ee90"
 		default:
 			return utils.TerminalColorGreen
 		}
-	case IssueStatusClosedWoAction:
-		switch kind {
-		case ColorKindDot:
-			return "purple"
-		default:
-			return utils.TerminalColorPurple
-		}
-	case IssueStatusInProgress:
+	case ri.IsInProgress():
 		switch kind {
 		case ColorKindDot:
 			return "pink"
+		case ColorKindSvg:
+			return "#f4a6b0"
 		default:
 			return utils.TerminalColorRed
 		}
-	case IssueStatusInQueue:
-		switch kind {
-		case ColorKindDot:
-			return "yellow"
-		default:
-			return utils.TerminalColorYellow
-		}
 	default:
 		switch kind {
 		case ColorKindDot:
 			return "white"
+		case ColorKindSvg:
+			return "#dcdcdc"
 		default:
 			return utils.TerminalColorLightGray
 		}
@@ -0,0 +1,184 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// JournalSession summarizes the entries of one session.
+type JournalSession struct {
+	Id      string
+	Command string
+	Entries []JournalEntry
+}
+
+// JournalSessions groups the entries by session, oldest first.
+func JournalSessions(entries []JournalEntry) []JournalSession {
+	var result []JournalSession
+	index := make(map[string]int)
+	for _, e := range entries {
+		i, ok := index[e.Session]
+		if !ok {
+			i = len(result)
+			index[e.Session] = i
+			result = append(result, JournalSession{Id: e.Session, Command: e.Command})
+		}
+		result[i].Entries = append(result[i].Entries, e)
+	}
+	return result
+}
+
+// SelectForUndo returns the entries to undo, newest first; either the
+// last n writes that weren't themselves undos, or all the writes of
+// the given session.  Writes already undone are skipped.
+func SelectForUndo(
+	entries []JournalEntry, n int, session string) ([]JournalEntry, error) {
+	undone := make(map[string]bool)
+	for _, e := range entries {
+		if e.Undoes != "" {
+			undone[e.Undoes] = true
+		}
+	}
+	var result []JournalEntry
+	found := false
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if session != "" {
+			if e.Session != session {
+				continue
+			}
+			found = true
+		} else if len(result) >= n {
+			break
+		} else if e.Undoes != "" {
+			continue
+		}
+		if !undone[e.Id] {
+			result = append(result, e)
+		}
+	}
+	if session != "" && !found {
+		return nil, fmt.Errorf("no session %q in the journal", session)
+	}
+	return result, nil
+}
+
+// Undo reverses the write recorded in the entry.  The writes made
+// to do so are themselves journaled, as undoing the entry.
+func (jb *JiraBoss) Undo(e *JournalEntry) error {
+	jb.undoing = e.Id
+	defer func() { jb.undoing = "" }()
+	var err error
+	switch e.Kind {
+	case WriteKindFields:
+		req := map[string]any{"fields": e.Before.Fields}
+		_, err = jb.punchItChewie(http.MethodPut, req, e.Path)
+	case WriteKindTransition:
+		err = jb.undoTransition(e)
+	case WriteKindCommentAdd:
+		if e.Before.CommentId == "" {
+			return fmt.Errorf("the id of the comment added to %s is unknown", e.Key)
+		}
+		_, err = jb.punchItChewie(
+			http.MethodDelete, nil, e.Path+"/"+e.Before.CommentId)
+	case WriteKindCommentEdit:
+		req := commentRequest{Body: e.Before.Comment}
+		_, err = jb.punchItChewie(http.MethodPut, req, e.Path)
+	case WriteKindCommentDelete:
+		req := commentRequest{Body: e.Before.Comment}
+		_, err = jb.punchItChewie(
+			http.MethodPost, req, e.Path[:strings.LastIndex(e.Path, "/")])
+	case WriteKindLinkAdd:
+		err = jb.undoLinkAdd(e)
+	case WriteKindLinkDelete:
+		err = jb.undoLinkDelete(e)
+	case WriteKindSprintMove:
+		err = jb.undoSprintMove(e)
+	default:
+		return fmt.Errorf("don't know how to undo %s %s", e.Method, e.Path)
+	}
+	if err != nil {
+		return fmt.Errorf("undoing %s; %w", e.Id, err)
+	}
+	return nil
+}
+
+// undoTransition moves the issue back to its old status,
+// if the workflow allows.
+func (jb *JiraBoss) undoTransition(e *JournalEntry) error {
+	key := ParseMyKey(e.Key)
+	if key.Proj != jb.Project() {
+		return fmt.Errorf("%s isn't in project %s; use --project", e.Key, jb.Project())
+	}
+	path, err := jb.FindTransitionPath(key.Num, e.Before.Status)
+	if err != nil {
+		return err
+	}
+	return jb.MoveIssueAlongPath(key.Num, path, &TransitionOptions{})
+}
+
+// journaledLink is the request made by LinkIssues.
+type journaledLink struct {
+	Type struct {
+		Name string `json:"name"`
+	} `json:"type"`
+	InwardIssue  linkEnd `json:"inwardIssue"`
+	OutwardIssue linkEnd `json:"outwardIssue"`
+}
+
+type linkEnd struct {
+	Key string `json:"key"`
+}
+
+func (jb *JiraBoss) undoLinkAdd(e *JournalEntry) error {
+	var req journaledLink
+	if err := json.Unmarshal(e.Request, &req); err != nil {
+		return err
+	}
+	ri, err := jb.fetchIssue(req.InwardIssue.Key, "issuelinks")
+	if err != nil {
+		return err
+	}
+	for _, link := range ri.Fields.IssueLinks {
+		if strings.EqualFold(link.Type.Name, req.Type.Name) &&
+			link.OutwardIssue.Key == req.OutwardIssue.Key {
+			return jb.deleteLink(link.Id)
+		}
+	}
+	return fmt.Errorf("no %s link from %s to %s remains",
+		req.Type.Name, req.InwardIssue.Key, req.OutwardIssue.Key)
+}
+
+func (jb *JiraBoss) undoLinkDelete(e *JournalEntry) error {
+	l := e.Before.Link
+	if l == nil {
+		return fmt.Errorf("the deleted link is unknown")
+	}
+	var req journaledLink
+	req.Type.Name = l.Type.Name
+	req.InwardIssue.Key = l.InwardIssue.Key
+	req.OutwardIssue.Key = l.OutwardIssue.Key
+	_, err := jb.punchItChewie(http.MethodPost, &req, endpointIssueLink)
+	return err
+}
+
+// undoSprintMove puts the issues back in their old sprints.
+func (jb *JiraBoss) undoSprintMove(e *JournalEntry) error {
+	bySprint := make(map[int][]string)
+	for key, sprint := range e.Before.Sprints {
+		bySprint[sprint] = append(bySprint[sprint], key)
+	}
+	for sprint, keys := range bySprint {
+		path := endpointAgileBacklog
+		if sprint != 0 {
+			path = endpointAgileSprint + "/" + strconv.Itoa(sprint) + "/issue"
+		}
+		if err := jb.moveKeys(path, keys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package myj
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectForUndo(t *testing.T) {
+	entries := []JournalEntry{
+		{Id: "s1.1", Session: "s1"},
+		{Id: "s1.2", Session: "s1"},
+		{Id: "s2.1", Session: "s2"},
+		{Id: "s2.2", Session: "s2"},
+		{Id: "s2.3", Session: "s2"},
+		// Undoes s2.3.
+		{Id: "s3.1", Session: "s3", Undoes: "s2.3"},
+	}
+	ids := func(es []JournalEntry) (result []string) {
+		for _, e := range es {
+			result = append(result, e.Id)
+		}
+		return
+	}
+	type testCase struct {
+		n        int
+		session  string
+		expected []string
+	}
+	tests := map[string]testCase{
+		// The undo and what it undid are both skipped.
+		"last1": {n: 1, expected: []string{"s2.2"}},
+		"last3": {n: 3, expected: []string{"s2.2", "s2.1", "s1.2"}},
+		"lastAll": {
+			n: 10, expected: []string{"s2.2", "s2.1", "s1.2", "s1.1"}},
+		"session":       {session: "s1", expected: []string{"s1.2", "s1.1"}},
+		"sessionUndone": {session: "s2", expected: []string{"s2.2", "s2.1"}},
+		// Undoing the undo redoes it.
+		"sessionOfUndos": {session: "s3", expected: []string{"s3.1"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := SelectForUndo(entries, tc.n, tc.session)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, ids(result))
+		})
+	}
+	_, err := SelectForUndo(entries, 0, "nope")
+	assert.ErrorContains(t, err, `no session "nope"`)
+}
@@ -0,0 +1,274 @@
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/monopole/gojira/internal/jql"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// Fields a transition screen may require that we know how to fill.
+const (
+	fieldResolution = "resolution"
+	fieldComment    = "comment"
+)
+
+// Transition is a move from an issue's current status to another.
+type Transition struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	To   struct {
+		Name string `json:"name"`
+	} `json:"to"`
+	// Fields are the fields on the transition's screen, keyed by field id.
+	Fields map[string]TransitionField `json:"fields,omitempty"`
+}
+
+// TransitionField is a field on a transition's screen.
+type TransitionField struct {
+	Required      bool   `json:"required"`
+	Name          string `json:"name"`
+	AllowedValues []struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"allowedValues,omitempty"`
+}
+
+// TransitionStep is one hop in a path through the workflow.
+type TransitionStep struct {
+	From string
+	Name string
+	To   string
+}
+
+func (s TransitionStep) String() string {
+	return fmt.Sprintf("%s --(%s)--> %s", s.From, s.Name, s.To)
+}
+
+// TransitionOptions supplies values for fields a transition
+// screen requires.
+type TransitionOptions struct {
+	// Resolution is the resolution name to use when one is required.
+	// If empty, "Done" is used if allowed, else the first allowed value.
+	Resolution string
+	// Comment is added with the last transition, or with any
+	// that requires one.
+	Comment string
+}
+
+// GetTransitions returns the transitions available from the issue's
+// current status, including the fields on their screens.
+func (jb *JiraBoss) GetTransitions(key MyKey) ([]Transition, error) {
+	body, err := jb.punchItChewie(
+		http.MethodGet, nil,
+		endpointIssue+"/"+key.String()+"/transitions?expand=transitions.fields")
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Transitions []Transition `json:"transitions"`
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("trouble unmarshaling transitions; %w", err)
+	}
+	return resp.Transitions, nil
+}
+
+// exampleInStatus returns some issue of the given type in the
+// given status, or nil if there isn't one.
+func (jb *JiraBoss) exampleInStatus(issueType, status string) (*ResponseIssue, error) {
+	req := makeSearchRequest(jql.And(
+		jb.inProject(),
+		jql.Eq("issuetype", issueType),
+		jql.Eq("status", status),
+	).String())
+	req.MaxResults = 1
+	resp, err := jb.doOneSearchRequest(req)
+	if err != nil || len(resp.Issues) == 0 {
+		return nil, err
+	}
+	return &resp.Issues[0], nil
+}
+
+// FindTransitionPath returns the shortest sequence of transitions that
+// moves the issue to the target status.  The workflow isn't readable
+// without admin rights, so it's discovered by asking for the transitions
+// of issues of the same type that are already in the intermediate
+// statuses.  Returns an empty path if the issue is already there.
+func (jb *JiraBoss) FindTransitionPath(issue int, target string) ([]TransitionStep, error) {
+	ri, err := jb.GetOneIssue(issue)
+	if err != nil {
+		return nil, err
+	}
+	start := ri.StatusRaw()
+	path, err := shortestTransitionPath(start, target,
+		func(status string) ([]Transition, error) {
+			if status == start {
+				return jb.GetTransitions(ri.MyKey)
+			}
+			example, err := jb.exampleInStatus(ri.TypeRaw(), status)
+			if err != nil || example == nil {
+				// Can't see where this status leads.
+				return nil, err
+			}
+			return jb.GetTransitions(example.MyKey)
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s; %w", ri.MyKey, err)
+	}
+	return path, nil
+}
+
+// shortestTransitionPath searches the workflow, as revealed by
+// transitionsFrom, for the shortest path from start to target.
+// Returns an empty path if start is the target.
+func shortestTransitionPath(
+	start, target string,
+	transitionsFrom func(status string) ([]Transition, error)) ([]TransitionStep, error) {
+	if strings.EqualFold(start, target) {
+		return nil, nil
+	}
+	// Breadth first, so the first path found is a shortest one.
+	via := map[string]TransitionStep{start: {}}
+	queue := []string{start}
+	for len(queue) > 0 {
+		status := queue[0]
+		queue = queue[1:]
+		transitions, err := transitionsFrom(status)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range transitions {
+			if _, seen := via[t.To.Name]; seen {
+				continue
+			}
+			via[t.To.Name] = TransitionStep{From: status, Name: t.Name, To: t.To.Name}
+			if strings.EqualFold(t.To.Name, target) {
+				var path []TransitionStep
+				for s := t.To.Name; s != start; s = via[s].From {
+					path = append([]TransitionStep{via[s]}, path...)
+				}
+				return path, nil
+			}
+			queue = append(queue, t.To.Name)
+		}
+	}
+	return nil, fmt.Errorf(
+		"found no path from %q to %q; %d statuses reachable",
+		start, target, len(via))
+}
+
+// MoveIssueAlongPath makes the transitions in the path, one at a time,
+// filling in the fields their screens require.
+func (jb *JiraBoss) MoveIssueAlongPath(
+	issue int, path []TransitionStep, opts *TransitionOptions) error {
+	key := jb.Key(issue)
+	for i, step := range path {
+		if i > 0 && jb.DryRun() {
+			// The issue hasn't really moved, so the transitions
+			// from here on can't be looked up.
+			utils.DoErrF("Would then %s\n", step)
+			continue
+		}
+		transitions, err := jb.GetTransitions(key)
+		if err != nil {
+			return err
+		}
+		var found *Transition
+		for j := range transitions {
+			if strings.EqualFold(transitions[j].To.Name, step.To) {
+				found = &transitions[j]
+				break
+			}
+		}
+		if found == nil {
+			return fmt.Errorf("%s cannot move to %q from here", key, step.To)
+		}
+		if err = jb.doTransition(key, found, opts, i == len(path)-1); err != nil {
+			return fmt.Errorf("moving %s to %q; %w", key, step.To, err)
+		}
+	}
+	return nil
+}
+
+// doTransition makes one transition.  The comment, if any, is added
+// on the last transition, or on any that requires it.
+func (jb *JiraBoss) doTransition(
+	key MyKey, t *Transition, opts *TransitionOptions, last bool) error {
+	type named struct {
+		Name string `json:"name"`
+	}
+	type comment struct {
+		Add struct {
+			Body string `json:"body"`
+		} `json:"add"`
+	}
+	var req struct {
+		Transition struct {
+			Id string `json:"id"`
+		} `json:"transition"`
+		Fields map[string]any       `json:"fields,omitempty"`
+		Update map[string][]comment `json:"update,omitempty"`
+	}
+	req.Transition.Id = t.Id
+	addComment := last
+	for id, f := range t.Fields {
+		if !f.Required {
+			continue
+		}
+		switch id {
+		case fieldResolution:
+			name, err := pickResolution(f, opts.Resolution)
+			if err != nil {
+				return err
+			}
+			if req.Fields == nil {
+				req.Fields = make(map[string]any)
+			}
+			req.Fields[id] = named{Name: name}
+		case fieldComment:
+			if opts.Comment == "" {
+				return fmt.Errorf("transition %q requires a comment", t.Name)
+			}
+			addComment = true
+		default:
+			return fmt.Errorf(
+				"transition %q requires field %q, which gojira can't fill",
+				t.Name, f.Name)
+		}
+	}
+	if addComment && opts.Comment != "" {
+		var c comment
+		c.Add.Body = opts.Comment
+		req.Update = map[string][]comment{fieldComment: {c}}
+	}
+	_, err := jb.punchItChewie(
+		http.MethodPost, &req, endpointIssue+"/"+key.String()+"/transitions")
+	return err
+}
+
+func pickResolution(f TransitionField, want string) (string, error) {
+	if len(f.AllowedValues) == 0 {
+		if want == "" {
+			return "", fmt.Errorf("a resolution is required")
+		}
+		return want, nil
+	}
+	if want == "" {
+		want = IssueStatusDone.String()
+	}
+	var names []string
+	for _, v := range f.AllowedValues {
+		if strings.EqualFold(v.Name, want) {
+			return v.Name, nil
+		}
+		names = append(names, v.Name)
+	}
+	if want == IssueStatusDone.String() {
+		return f.AllowedValues[0].Name, nil
+	}
+	return "", fmt.Errorf("resolution %q not one of %v", want, names)
+}
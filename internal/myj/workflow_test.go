@@ -0,0 +1,87 @@
+package myj
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortestTransitionPath(t *testing.T) {
+	// A workflow, as the names of the transitions out of each status.
+	workflow := map[string][]string{
+		"Backlog":     {"Ready", "Closed"},
+		"Ready":       {"In Progress", "Backlog"},
+		"In Progress": {"In Review", "Ready"},
+		"In Review":   {"Done", "In Progress"},
+		"Done":        {"Backlog"},
+	}
+	var asked []string
+	transitionsFrom := func(status string) ([]Transition, error) {
+		asked = append(asked, status)
+		var result []Transition
+		for _, to := range workflow[status] {
+			var t Transition
+			t.Name = "to " + to
+			t.To.Name = to
+			result = append(result, t)
+		}
+		return result, nil
+	}
+	type testCase struct {
+		start, target string
+		expected      []string
+		err           string
+	}
+	tests := map[string]testCase{
+		"already there": {start: "Ready", target: "ready"},
+		"one step":      {start: "Backlog", target: "Ready", expected: []string{"Ready"}},
+		"shortest": {
+			start: "In Review", target: "Backlog",
+			expected: []string{"Done", "Backlog"},
+		},
+		"long": {
+			start: "Backlog", target: "Done",
+			expected: []string{"Ready", "In Progress", "In Review", "Done"},
+		},
+		// Closed is a dead end.
+		"no path": {
+			start: "Closed", target: "Done",
+			err: `found no path from "Closed" to "Done"; 1 statuses reachable`,
+		},
+		"unknown target": {
+			start: "Backlog", target: "Nowhere",
+			err: `found no path from "Backlog" to "Nowhere"; 6 statuses reachable`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			asked = nil
+			path, err := shortestTransitionPath(tc.start, tc.target, transitionsFrom)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			var to []string
+			from := tc.start
+			for _, step := range path {
+				assert.Equal(t, from, step.From)
+				assert.Equal(t, "to "+step.To, step.Name)
+				to = append(to, step.To)
+				from = step.To
+			}
+			assert.Equal(t, tc.expected, to)
+		})
+	}
+
+	// Nothing is fetched when there's nowhere to go.
+	asked = nil
+	_, err := shortestTransitionPath("Done", "Done", transitionsFrom)
+	assert.NoError(t, err)
+	assert.Empty(t, asked)
+
+	_, err = shortestTransitionPath("Backlog", "Done",
+		func(string) ([]Transition, error) { return nil, errors.New("boom") })
+	assert.EqualError(t, err, "boom")
+}
@@ -0,0 +1,19 @@
+package myj
+
+// WriteKind classifies the writes recorded in the journal,
+// by what it takes to undo them.
+//
+//go:generate go run github.com/dmarkham/enumer -linecomment -json -type=WriteKind
+type WriteKind int
+
+const (
+	WriteKindUnknown       WriteKind = iota // unknown
+	WriteKindFields                         // fields
+	WriteKindTransition                     // transition
+	WriteKindCommentAdd                     // comment-add
+	WriteKindCommentEdit                    // comment-edit
+	WriteKindCommentDelete                  // comment-delete
+	WriteKindLinkAdd                        // link-add
+	WriteKindLinkDelete                     // link-delete
+	WriteKindSprintMove                     // sprint-move
+)
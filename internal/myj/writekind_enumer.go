@@ -0,0 +1,124 @@
+// Code generated by "enumer -linecomment -json -type=WriteKind"; DO NOT EDIT.
+
+package myj
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _WriteKindName = "unknownfieldstransitioncomment-addcomment-editcomment-deletelink-addlink-deletesprint-move"
+
+var _WriteKindIndex = [...]uint8{0, 7, 13, 23, 34, 46, 60, 68, 79, 90}
+
+const _WriteKindLowerName = "unknownfieldstransitioncomment-addcomment-editcomment-deletelink-addlink-deletesprint-move"
+
+func (i WriteKind) String() string {
+	if i < 0 || i >= WriteKind(len(_WriteKindIndex)-1) {
+		return fmt.Sprintf("WriteKind(%d)", i)
+	}
+	return _WriteKindName[_WriteKindIndex[i]:_WriteKindIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _WriteKindNoOp() {
+	var x [1]struct{}
+	_ = x[WriteKindUnknown-(0)]
+	_ = x[WriteKindFields-(1)]
+	_ = x[WriteKindTransition-(2)]
+	_ = x[WriteKindCommentAdd-(3)]
+	_ = x[WriteKindCommentEdit-(4)]
+	_ = x[WriteKindCommentDelete-(5)]
+	_ = x[WriteKindLinkAdd-(6)]
+	_ = x[WriteKindLinkDelete-(7)]
+	_ = x[WriteKindSprintMove-(8)]
+}
+
+var _WriteKindValues = []WriteKind{WriteKindUnknown, WriteKindFields, WriteKindTransition, WriteKindCommentAdd, WriteKindCommentEdit, WriteKindCommentDelete, WriteKindLinkAdd, WriteKindLinkDelete, WriteKindSprintMove}
+
+var _WriteKindNameToValueMap = map[string]WriteKind{
+	_WriteKindName[0:7]:        WriteKindUnknown,
+	_WriteKindLowerName[0:7]:   WriteKindUnknown,
+	_WriteKindName[7:13]:       WriteKindFields,
+	_WriteKindLowerName[7:13]:  WriteKindFields,
+	_WriteKindName[13:23]:      WriteKindTransition,
+	_WriteKindLowerName[13:23]: WriteKindTransition,
+	_WriteKindName[23:34]:      WriteKindCommentAdd,
+	_WriteKindLowerName[23:34]: WriteKindCommentAdd,
+	_WriteKindName[34:46]:      WriteKindCommentEdit,
+	_WriteKindLowerName[34:46]: WriteKindCommentEdit,
+	_WriteKindName[46:60]:      WriteKindCommentDelete,
+	_WriteKindLowerName[46:60]: WriteKindCommentDelete,
+	_WriteKindName[60:68]:      WriteKindLinkAdd,
+	_WriteKindLowerName[60:68]: WriteKindLinkAdd,
+	_WriteKindName[68:79]:      WriteKindLinkDelete,
+	_WriteKindLowerName[68:79]: WriteKindLinkDelete,
+	_WriteKindName[79:90]:      WriteKindSprintMove,
+	_WriteKindLowerName[79:90]: WriteKindSprintMove,
+}
+
+var _WriteKindNames = []string{
+	_WriteKindName[0:7],
+	_WriteKindName[7:13],
+	_WriteKindName[13:23],
+	_WriteKindName[23:34],
+	_WriteKindName[34:46],
+	_WriteKindName[46:60],
+	_WriteKindName[60:68],
+	_WriteKindName[68:79],
+	_WriteKindName[79:90],
+}
+
+// WriteKindString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func WriteKindString(s string) (WriteKind, error) {
+	if val, ok := _WriteKindNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _WriteKindNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to WriteKind values", s)
+}
+
+// WriteKindValues returns all values of the enum
+func WriteKindValues() []WriteKind {
+	return _WriteKindValues
+}
+
+// WriteKindStrings returns a slice of all String values of the enum
+func WriteKindStrings() []string {
+	strs := make([]string, len(_WriteKindNames))
+	copy(strs, _WriteKindNames)
+	return strs
+}
+
+// IsAWriteKind returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i WriteKind) IsAWriteKind() bool {
+	for _, v := range _WriteKindValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for WriteKind
+func (i WriteKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for WriteKind
+func (i *WriteKind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("WriteKind should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = WriteKindString(s)
+	return err
+}
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/monopole/gojira/internal/myj"
+)
+
+const noEpic = "(no epic)"
+
+// DoActivity writes a user's activity, grouped by kind and then by epic,
+// as plain text or markdown.  The markdown is meant to be pasted into
+// a status doc.
+func DoActivity(w io.Writer, a *myj.Activity, f Format) {
+	md := f == FormatMarkdown
+	title := fmt.Sprintf("Activity of %s, %s", a.User, a.Range.PrettyRange())
+	if md {
+		_, _ = fmt.Fprintf(w, "## %s\n", title)
+	} else {
+		_, _ = fmt.Fprintln(w, title)
+	}
+	for _, kind := range []struct {
+		name   string
+		issues map[myj.MyKey]myj.IssueList
+	}{
+		{"Created", a.Created},
+		{"Commented on", a.Commented},
+		{"Resolved", a.Resolved},
+	} {
+		count := 0
+		for _, list := range kind.issues {
+			count += len(list)
+		}
+		_, _ = fmt.Fprintln(w)
+		if md {
+			_, _ = fmt.Fprintf(w, "### %s (%d)\n\n", kind.name, count)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s (%d)\n", kind.name, count)
+		}
+		for _, epicKey := range sortedActivityKeys(kind.issues) {
+			epicName := activityEpicName(epicKey, a.Epics[epicKey])
+			if md {
+				_, _ = fmt.Fprintf(w, "- %s\n", epicName)
+			} else {
+				_, _ = fmt.Fprintf(w, "  %s\n", epicName)
+			}
+			for _, issue := range kind.issues[epicKey] {
+				if issue.MyKey == epicKey {
+					// The epic itself was touched; it's already named.
+					continue
+				}
+				line := fmt.Sprintf("%s %s (%s)",
+					issue.MyKey, issue.MySummary(), issue.StatusRaw())
+				if md {
+					_, _ = fmt.Fprintf(w, "  - %s\n", line)
+				} else {
+					_, _ = fmt.Fprintf(w, "    %s\n", line)
+				}
+			}
+		}
+	}
+}
+
+func activityEpicName(k myj.MyKey, epic *myj.ResponseIssue) string {
+	if k.Num >= myj.UnknownEpicBase {
+		return noEpic
+	}
+	if epic == nil {
+		return k.String()
+	}
+	return k.String() + " " + epic.MySummary()
+}
+
+// sortedActivityKeys returns the epic keys in key order, with
+// the issues that have no epic last.
+func sortedActivityKeys(m map[myj.MyKey]myj.IssueList) []myj.MyKey {
+	keys := make([]myj.MyKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ui, uj := keys[i].Num >= myj.UnknownEpicBase, keys[j].Num >= myj.UnknownEpicBase
+		if ui != uj {
+			return uj
+		}
+		return keys[i].Less(keys[j])
+	})
+	return keys
+}
@@ -0,0 +1,303 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+const (
+	// throughputDays is how far back to look to estimate throughput.
+	throughputDays = 14
+	// maxProjectionDays limits how far past the epic's end the chart goes.
+	maxProjectionDays = 180
+
+	burnHeight   = 12
+	burnMaxWidth = 100
+)
+
+// burndown holds the number of an epic's stories done on each day.
+// Scope is taken to be all the stories the epic has now.
+type burndown struct {
+	epic *myj.ResponseIssue
+	// epicDr is the epic's own range.
+	epicDr *utils.DayRange
+	// dr is the charted range; epicDr stretched to hold today
+	// and the projected completion.
+	dr    *utils.DayRange
+	total int
+	// done[i] is the number of stories resolved by the i'th day of dr.
+	// It stops at today.
+	done []int
+	// rate is recent throughput in stories per day.
+	rate      float64
+	projected utils.Date
+}
+
+func makeBurndown(
+	epic *myj.ResponseIssue, stories myj.IssueList,
+	today utils.Date) (*burndown, error) {
+	epicDr, err := utils.MakeDayRangeGentle(epic.DateStart(), epic.DateEnd())
+	if err != nil {
+		return nil, fmt.Errorf("%s has bad dates; %w", epic.MyKey, err)
+	}
+	b := &burndown{
+		epic:      epic,
+		epicDr:    epicDr,
+		total:     len(stories),
+		projected: utils.GoEpicDate,
+	}
+	var resolved []utils.Date
+	for _, s := range stories {
+		if d := s.DateResolved(); d.IsDefined() {
+			resolved = append(resolved, d)
+		}
+	}
+	doneBy := func(day utils.Date) (count int) {
+		for _, d := range resolved {
+			if !d.After(day) {
+				count++
+			}
+		}
+		return
+	}
+	remaining := b.total - doneBy(today)
+	recent := doneBy(today) - doneBy(today.AddDays(-throughputDays))
+	b.rate = float64(recent) / throughputDays
+	switch {
+	case remaining == 0:
+		for _, d := range resolved {
+			if !b.projected.IsDefined() || d.After(b.projected) {
+				b.projected = d
+			}
+		}
+	case b.rate > 0:
+		b.projected = today.AddDays(int(math.Ceil(float64(remaining) / b.rate)))
+	}
+
+	end := epicDr.End()
+	if today.After(end) {
+		end = today
+	}
+	if b.projected.IsDefined() && b.projected.After(end) {
+		end = b.projected
+	}
+	if limit := epicDr.End().AddDays(maxProjectionDays); end.After(limit) {
+		end = limit
+	}
+	if b.dr, err = utils.MakeDayRangeGentle(epicDr.Start(), end); err != nil {
+		return nil, err
+	}
+	for day := b.dr.Start(); !day.After(today) && !day.After(b.dr.End()); day = day.AddDays(1) {
+		b.done = append(b.done, doneBy(day))
+	}
+	return b, nil
+}
+
+func (b *burndown) days() int {
+	return b.dr.Start().DayCount(b.dr.End())
+}
+
+// ideal is the ideal number of remaining stories on the i'th day,
+// falling evenly to zero over the epic's own range.
+func (b *burndown) ideal(i int) float64 {
+	n := b.epicDr.Start().DayCount(b.epicDr.End()) - 1
+	if n <= 0 || i >= n {
+		return 0
+	}
+	return float64(b.total) * (1 - float64(i)/float64(n))
+}
+
+// projection is the projected number of remaining stories on the i'th
+// day, for days after today, falling evenly to zero at the projected date.
+func (b *burndown) projection(i int) (float64, bool) {
+	today := len(b.done) - 1
+	if i <= today || today < 0 || !b.projected.IsDefined() {
+		return 0, false
+	}
+	remaining := float64(b.total - b.done[today])
+	n := b.dr.Start().AddDays(today).DayCount(b.projected) - 1
+	if n <= 0 {
+		return 0, true
+	}
+	return max(0, remaining*(1-float64(i-today)/float64(n))), true
+}
+
+func (b *burndown) writeSummary(w io.Writer) {
+	today := len(b.done) - 1
+	done := 0
+	if today >= 0 {
+		done = b.done[today]
+	}
+	_, _ = fmt.Fprintf(w, "%s %s\n", b.epic.MyKey, b.epic.MySummary())
+	_, _ = fmt.Fprintf(w, "  %d stories, %d done, %d remaining\n",
+		b.total, done, b.total-done)
+	_, _ = fmt.Fprintf(w, "  epic dates %s\n", b.epicDr.PrettyRange())
+	_, _ = fmt.Fprintf(w, "  throughput %.2f stories/day over the last %d days\n",
+		b.rate, throughputDays)
+	switch {
+	case !b.projected.IsDefined():
+		_, _ = fmt.Fprintln(w, "  no projected completion at the current throughput")
+	case done == b.total:
+		_, _ = fmt.Fprintf(w, "  completed %s\n", b.projected)
+	default:
+		slack := b.projected.DayCount(b.epicDr.End()) - 1
+		when := fmt.Sprintf("%d days before", slack)
+		if slack < 0 {
+			when = fmt.Sprintf("%d days after", -slack)
+		}
+		_, _ = fmt.Fprintf(w, "  projected completion %s, %s the target date\n",
+			b.projected, when)
+	}
+}
+
+// DoBurndown writes an ascii chart of an epic's remaining and completed
+// stories, along with an ideal line and the projected completion.
+func DoBurndown(
+	w io.Writer, epic *myj.ResponseIssue, stories myj.IssueList) error {
+	b, err := makeBurndown(epic, stories, utils.Today())
+	if err != nil {
+		return err
+	}
+	b.writeSummary(w)
+	_, _ = fmt.Fprintln(w)
+	if b.total == 0 {
+		return nil
+	}
+	// Each column covers this many days.
+	step := (b.days() + burnMaxWidth - 1) / burnMaxWidth
+	cols := (b.days() + step - 1) / step
+	level := func(v float64) int {
+		return int(math.Round(v / float64(b.total) * (burnHeight - 1)))
+	}
+	grid := make([][]rune, burnHeight)
+	for r := range grid {
+		grid[r] = []rune(strings.Repeat(" ", cols))
+	}
+	plot := func(c int, v float64, ch rune) {
+		r := burnHeight - 1 - level(v)
+		if grid[r][c] == ' ' || ch == '#' {
+			grid[r][c] = ch
+		}
+	}
+	for c := 0; c < cols; c++ {
+		i := c * step
+		if i < len(b.done) {
+			plot(c, float64(b.total-b.done[i]), '#')
+			plot(c, float64(b.done[i]), 'o')
+		} else if v, ok := b.projection(i); ok {
+			plot(c, v, '-')
+		}
+		plot(c, b.ideal(i), '.')
+	}
+	for r, row := range grid {
+		label := ""
+		switch r {
+		case 0:
+			label = fmt.Sprint(b.total)
+		case burnHeight - 1:
+			label = "0"
+		}
+		_, _ = fmt.Fprintf(w, "%4s │%s\n", label, strings.TrimRight(string(row), " "))
+	}
+	_, _ = fmt.Fprintf(w, "%4s └%s\n", "", strings.Repeat("─", cols))
+	if today := len(b.done) - 1; today >= 0 && today/step < cols {
+		_, _ = fmt.Fprintf(w, "%4s  %s^ today\n", "", strings.Repeat(" ", today/step))
+	}
+	start, end := b.dr.Start().String(), b.dr.End().String()
+	_, _ = fmt.Fprintf(w, "%4s  %s%*s\n", "", start, max(cols-len(start), len(end)+1), end)
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "  # remaining   o completed   . ideal   - projected")
+	return nil
+}
+
+// Dimensions (in pixels) of the burndown chart.
+const (
+	burnSvgWidth  = 720
+	burnSvgHeight = 320
+	burnSvgMargin = 40
+)
+
+// DoBurndownSvg is DoBurndown as an SVG document.
+func DoBurndownSvg(
+	w io.Writer, epic *myj.ResponseIssue, stories myj.IssueList) error {
+	b, err := makeBurndown(epic, stories, utils.Today())
+	if err != nil {
+		return err
+	}
+	plotW := burnSvgWidth - 2*burnSvgMargin
+	plotH := burnSvgHeight - 2*burnSvgMargin
+	x := func(i int) int {
+		return burnSvgMargin + i*plotW/max(b.days()-1, 1)
+	}
+	y := func(v float64) int {
+		return burnSvgMargin + plotH - int(v/float64(max(b.total, 1))*float64(plotH))
+	}
+	line := func(color, dash string, points []string) {
+		if len(points) < 2 {
+			return
+		}
+		_, _ = fmt.Fprintf(w,
+			`<polyline fill="none" stroke="%s" stroke-width="2"%s points="%s"/>`+"\n",
+			color, dash, strings.Join(points, " "))
+	}
+	_, _ = fmt.Fprintf(w,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" `+
+			`font-family="sans-serif" font-size="%d">`+"\n",
+		burnSvgWidth, burnSvgHeight, svgFontSize)
+	_, _ = fmt.Fprintf(w, `<rect width="%d" height="%d" fill="white"/>`+"\n",
+		burnSvgWidth, burnSvgHeight)
+	_, _ = fmt.Fprintf(w, `<text x="%d" y="20" font-weight="bold">%s</text>`+"\n",
+		burnSvgMargin, html.EscapeString(epic.MyKey.String()+" "+epic.MySummary()))
+	_, _ = fmt.Fprintf(w,
+		`<path d="M%d,%d V%d H%d" fill="none" stroke="#999"/>`+"\n",
+		burnSvgMargin, burnSvgMargin, burnSvgMargin+plotH, burnSvgMargin+plotW)
+	_, _ = fmt.Fprintf(w, `<text x="%d" y="%d" text-anchor="end">%d</text>`+"\n",
+		burnSvgMargin-4, burnSvgMargin+4, b.total)
+	_, _ = fmt.Fprintf(w, `<text x="%d" y="%d" text-anchor="end">0</text>`+"\n",
+		burnSvgMargin-4, burnSvgMargin+plotH+4)
+	_, _ = fmt.Fprintf(w, `<text x="%d" y="%d">%s</text>`+"\n",
+		burnSvgMargin, burnSvgMargin+plotH+16, b.dr.Start())
+	_, _ = fmt.Fprintf(w, `<text x="%d" y="%d" text-anchor="end">%s</text>`+"\n",
+		burnSvgMargin+plotW, burnSvgMargin+plotH+16, b.dr.End())
+
+	var ideal, remaining, completed, projected []string
+	pt := func(i int, v float64) string {
+		return fmt.Sprintf("%d,%d", x(i), y(v))
+	}
+	for i := 0; i < b.days(); i++ {
+		ideal = append(ideal, pt(i, b.ideal(i)))
+		if i < len(b.done) {
+			remaining = append(remaining, pt(i, float64(b.total-b.done[i])))
+			completed = append(completed, pt(i, float64(b.done[i])))
+		} else if v, ok := b.projection(i); ok {
+			if len(projected) == 0 && len(remaining) > 0 {
+				projected = append(projected, remaining[len(remaining)-1])
+			}
+			projected = append(projected, pt(i, v))
+		}
+	}
+	line("#aaa", ` stroke-dasharray="4,3"`, ideal)
+	line("#c0392b", "", remaining)
+	line("#27ae60", "", completed)
+	line("#c0392b", ` stroke-dasharray="2,3"`, projected)
+	if today := len(b.done) - 1; today >= 0 && today < b.days() {
+		_, _ = fmt.Fprintf(w,
+			`<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="red" stroke-width="1"/>`+"\n",
+			x(today), burnSvgMargin, x(today), burnSvgMargin+plotH)
+	}
+	var summary strings.Builder
+	b.writeSummary(&summary)
+	for i, s := range strings.Split(strings.TrimSpace(summary.String()), "\n")[1:] {
+		_, _ = fmt.Fprintf(w, `<text x="%d" y="%d" font-size="11" fill="#555">%s</text>`+"\n",
+			burnSvgMargin+plotW-260, burnSvgMargin+14+14*i,
+			html.EscapeString(strings.TrimSpace(s)))
+	}
+	_, _ = fmt.Fprintln(w, "</svg>")
+	return nil
+}
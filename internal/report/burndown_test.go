@@ -0,0 +1,89 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// burnEpic makes an epic running over the given days of March 2026,
+// with one story per entry in resolved; zero means not resolved,
+// else it's the day of March the story was resolved.
+func burnEpic(start, end int, resolved ...int) (*myj.ResponseIssue, myj.IssueList) {
+	march := func(d int) utils.Date { return utils.MakeDate(2026, time.March, d) }
+	epic := &myj.ResponseIssue{MyKey: myj.MyKey{Proj: "BUDS", Num: 1}}
+	epic.Fields.CustomStartDate = march(start).JiraFormat()
+	epic.Fields.CustomTargetCompletionDate = march(end).JiraFormat()
+	var stories myj.IssueList
+	for _, d := range resolved {
+		s := &myj.ResponseIssue{}
+		if d > 0 {
+			s.Fields.ResolutionDate = march(d).JiraFormat() + "T10:00:00.000+0000"
+		}
+		stories = append(stories, s)
+	}
+	return epic, stories
+}
+
+func TestMakeBurndown(t *testing.T) {
+	today := utils.MakeDate(2026, time.March, 16)
+
+	// Seven of ten done, all in the last two weeks; a rate of
+	// half a story a day leaves six days to go.
+	epic, stories := burnEpic(2, 31, 3, 3, 5, 10, 10, 10, 14, 0, 0, 0)
+	b, err := makeBurndown(epic, stories, today)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, b.total)
+	assert.Equal(t, 30, b.days())
+	assert.Equal(t, 0.5, b.rate)
+	assert.Equal(t, utils.MakeDate(2026, time.March, 22), b.projected)
+	// Counts run from the epic's start up to today.
+	assert.Len(t, b.done, 15)
+	assert.Equal(t, []int{0, 2, 2, 3}, b.done[:4])
+	assert.Equal(t, 6, b.done[8])
+	assert.Equal(t, 7, b.done[14])
+	// The ideal falls evenly from all to none over the epic's range.
+	assert.Equal(t, 10.0, b.ideal(0))
+	assert.InDelta(t, 10*(1-15.0/29), b.ideal(15), 0.001)
+	assert.Equal(t, 0.0, b.ideal(29))
+	assert.Equal(t, 0.0, b.ideal(40))
+	// The projection starts after today, from what remains,
+	// and reaches zero on the projected day.
+	_, ok := b.projection(14)
+	assert.False(t, ok)
+	p, ok := b.projection(15)
+	assert.True(t, ok)
+	assert.InDelta(t, 2.5, p, 0.001)
+	p, _ = b.projection(20)
+	assert.InDelta(t, 0, p, 0.001)
+	p, _ = b.projection(25)
+	assert.Equal(t, 0.0, p)
+
+	// All done; the projection is the last resolution.
+	epic, stories = burnEpic(2, 31, 3, 9)
+	b, err = makeBurndown(epic, stories, today)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.MakeDate(2026, time.March, 9), b.projected)
+
+	// Nothing done lately; no projection.
+	epic, stories = burnEpic(2, 31, 0, 0)
+	b, err = makeBurndown(epic, stories, today)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, b.rate)
+	assert.False(t, b.projected.IsDefined())
+	_, ok = b.projection(20)
+	assert.False(t, ok)
+
+	// Late and slow, one done of a hundred; the chart stretches
+	// past today, but no further than the limit.
+	resolved := make([]int, 100)
+	resolved[0] = 16
+	epic, stories = burnEpic(2, 6, resolved...)
+	b, err = makeBurndown(epic, stories, today)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		utils.MakeDate(2026, time.March, 6).AddDays(maxProjectionDays), b.dr.End())
+}
@@ -2,9 +2,12 @@ package report
 
 import (
 	"fmt"
+	"io"
+	"sort"
+	"strings"
+
 	"github.com/monopole/gojira/internal/myj"
 	"github.com/monopole/gojira/internal/utils"
-	"io"
 )
 
 const (
@@ -21,14 +24,39 @@ type CalParams struct {
 	ShowHeaders   bool
 	LineSetSize   int
 	ShowAssignee  bool
+	// Sprints, if any, have their boundaries drawn in the header.
+	Sprints []*utils.DayRange
+	GroupBy GroupBy
+	SortBy  SortBy
+	// ShowSlips adds a sparkline of end date changes to each epic.
+	// The epics must have been fetched with their changelogs.
+	ShowSlips bool
+	// DependencyLinkTypes name the link types drawn as dependencies
+	// in the gantt charts.
+	DependencyLinkTypes []string
+}
+
+// writeSlips writes the slip sparkline column, if it's wanted.
+// With no issue, the column is left blank.
+func writeSlips(w io.Writer, issue *myj.ResponseIssue, p CalParams) {
+	if !p.ShowSlips {
+		return
+	}
+	if issue == nil {
+		_, _ = fmt.Fprint(w, strings.Repeat(" ", slipWidth))
+	} else {
+		_, _ = fmt.Fprint(w, slipSparkline(issue))
+	}
+	_, _ = fmt.Fprint(w, spacer)
 }
 
 func DoCal(
 	w io.Writer,
 	epicMap map[myj.MyKey]*myj.ResponseIssue,
+	issueMap map[myj.MyKey]myj.IssueList,
 	p CalParams,
 ) error {
-	epicKeys := myj.GetSortedKeys(epicMap)
+	fmNested := fmt.Sprintf("%%%ds", p.FieldSizeName-len(nestMark))
 	fmProj := fmt.Sprintf("%%%ds", fieldSizeProj)
 	fmId := fmt.Sprintf("%%%dd", fieldSizeProj)
 	fmName := fmt.Sprintf("%%%ds", p.FieldSizeName)
@@ -37,25 +65,155 @@ func DoCal(
 		_, _ = fmt.Fprint(w, spacer)
 		_, _ = fmt.Fprintf(w, fmName, blankName)
 		_, _ = fmt.Fprint(w, spacer)
-		_, _ = fmt.Fprintln(w, p.Outer.MonthHeader())
+		writeSlips(w, nil, p)
+		_, _ = fmt.Fprintln(w, p.Outer.MarkBoundaries(p.Outer.MonthHeader(), p.Sprints))
 
 		h1, h2 := p.Outer.DayHeaders()
+		h1 = p.Outer.MarkBoundaries(h1, p.Sprints)
+		h2 = p.Outer.MarkBoundaries(h2, p.Sprints)
 		_, _ = fmt.Fprintf(w, fmProj, blankName)
 		_, _ = fmt.Fprint(w, spacer)
 		_, _ = fmt.Fprintf(w, fmName, blankName)
 		_, _ = fmt.Fprint(w, spacer)
+		writeSlips(w, nil, p)
 		_, _ = fmt.Fprintln(w, h1)
 
 		_, _ = fmt.Fprintf(w, fmProj, p.ProjectName)
 		_, _ = fmt.Fprint(w, spacer)
 		_, _ = fmt.Fprintf(w, fmName, blankName)
 		_, _ = fmt.Fprint(w, spacer)
+		writeSlips(w, nil, p)
 		_, _ = fmt.Fprintln(w, h2)
 	}
+	rows, errors := makeCalRows(epicMap, issueMap, p.SortBy)
+	rows = withSections(rows, p.GroupBy)
 	today := utils.Today()
 	lineCount := 0
-	var errors []error
-	for _, epicKey := range epicKeys {
+	// atBreak is true if a blank line was just printed.
+	atBreak := true
+	for i, row := range rows {
+		issue := row.issue
+		if row.section != "" {
+			if !atBreak {
+				_, _ = fmt.Fprintln(w)
+			}
+			_, _ = fmt.Fprintf(w, fmProj, blankName)
+			_, _ = fmt.Fprint(w, spacer)
+			if p.UseColor {
+				_, _ = fmt.Fprintln(w, utils.TerminalColorWhite+row.section+utils.TerminalReset)
+			} else {
+				_, _ = fmt.Fprintln(w, row.section)
+			}
+			lineCount = 0
+			atBreak = false
+			continue
+		}
+		if row.isSpan {
+			_, _ = fmt.Fprintf(w, fmProj, blankName)
+		} else {
+			_, _ = fmt.Fprintf(w, fmId, issue.MyKey.Num)
+		}
+		_, _ = fmt.Fprint(w, spacer)
+		if row.depth == 0 {
+			_, _ = fmt.Fprintf(
+				w, fmName, utils.Ellipsis(issue.MySummary(), p.FieldSizeName))
+		} else {
+			// Nested rows end short of the epic names, leaving
+			// a column to flag rows outside the epic's window.
+			_, _ = fmt.Fprintf(w, fmNested,
+				utils.Ellipsis(row.label(), p.FieldSizeName-len(nestMark)))
+			if row.outside {
+				_, _ = fmt.Fprint(w, outsideMark)
+			} else {
+				_, _ = fmt.Fprint(w, nestMark)
+			}
+		}
+		_, _ = fmt.Fprint(w, spacer)
+		if row.depth == 0 {
+			writeSlips(w, issue, p)
+		} else {
+			writeSlips(w, nil, p)
+		}
+		switch {
+		case row.noDates:
+			_, _ = fmt.Fprint(w, p.Outer.AsEmpty(today, "no dates", p.UseColor))
+		case row.isSpan:
+			_, _ = fmt.Fprint(w, row.dr.AsIntersect(
+				today, "", p.Outer, p.UseColor, utils.TerminalColorCyan))
+		default:
+			_, _ = fmt.Fprint(
+				w, row.dr.AsIntersect(
+					today,
+					func() string {
+						if p.ShowAssignee {
+							return issue.AssigneeName()
+						}
+						return ""
+					}(),
+					p.Outer, p.UseColor,
+					myj.StatusColor(issue, myj.ColorKindTerminal)))
+		}
+		_, _ = fmt.Fprintln(w)
+		if issueMap != nil {
+			// Separate each epic and its stories from the next epic.
+			atBreak = i+1 < len(rows) && rows[i+1].depth == 0
+			if atBreak {
+				_, _ = fmt.Fprintln(w)
+			}
+			continue
+		}
+		lineCount++
+		atBreak = lineCount%p.LineSetSize == 0
+		if atBreak {
+			_, _ = fmt.Fprintln(w)
+		}
+	}
+	return dateErrors(errors)
+}
+
+const (
+	nestMark    = "  "
+	outsideMark = " !"
+)
+
+// calRow is one line of a calendar, however the calendar is rendered.
+type calRow struct {
+	issue *myj.ResponseIssue
+	// dr is always usable, even if the issue's dates are bad.
+	dr *utils.DayRange
+	// err is non-nil if the issue's dates needed correction.
+	err error
+	// depth is zero for an epic, one for the epic's stories.
+	depth int
+	// noDates is true if the issue has neither a start nor an end date,
+	// so dr is meaningless.
+	noDates bool
+	// isSpan is true if this row spans all the stories of the epic
+	// in the issue field, rather than representing the epic itself.
+	isSpan bool
+	// outside is true if this row is not within its epic's window.
+	outside bool
+	// section, if not empty, makes this row a header for the rows
+	// that follow, and the other fields are unused.
+	section string
+}
+
+func (r *calRow) label() string {
+	if r.isSpan {
+		return "stories span"
+	}
+	return r.issue.MySummary()
+}
+
+// makeCalRows returns calendar rows for the given epics in the given order,
+// along with any date errors found in the epics.  If issueMap isn't nil,
+// each epic is followed by rows for its stories.
+func makeCalRows(
+	epicMap map[myj.MyKey]*myj.ResponseIssue,
+	issueMap map[myj.MyKey]myj.IssueList,
+	by SortBy,
+) (rows []calRow, errors []error) {
+	for _, epicKey := range sortedEpicKeys(epicMap, by) {
 		epic := epicMap[epicKey.MyKey]
 		dr, err := utils.MakeDayRangeGentle(epic.DateStart(), epic.DateEnd())
 		if err != nil {
@@ -63,28 +221,76 @@ func DoCal(
 				errors,
 				fmt.Errorf("%s; %w", epicKey.MyKey, err))
 		}
-		_, _ = fmt.Fprintf(w, fmId, epic.MyKey.Num)
-		_, _ = fmt.Fprint(w, spacer)
-		_, _ = fmt.Fprintf(
-			w, fmName, utils.Ellipsis(epic.MySummary(), p.FieldSizeName))
-		_, _ = fmt.Fprint(w, spacer)
-		_, _ = fmt.Fprint(
-			w, dr.AsIntersect(
-				today,
-				func() string {
-					if p.ShowAssignee {
-						return epic.AssigneeName()
-					}
-					return ""
-				}(),
-				p.Outer, p.UseColor,
-				myj.StatusColor(epic.Status(), myj.ColorKindTerminal)))
-		_, _ = fmt.Fprintln(w)
-		lineCount++
-		if lineCount%p.LineSetSize == 0 {
-			_, _ = fmt.Fprintln(w)
+		rows = append(rows, calRow{issue: epic, dr: dr, err: err})
+		if issueMap != nil {
+			rows = append(rows, makeStoryRows(epic, dr, issueMap[epicKey.MyKey])...)
+		}
+	}
+	return
+}
+
+// makeStoryRows returns rows for an epic's stories sorted by start date,
+// followed by a row spanning all the stories that have dates.
+func makeStoryRows(
+	epic *myj.ResponseIssue, epicDr *utils.DayRange,
+	stories myj.IssueList) (rows []calRow) {
+	var (
+		span    *utils.DayRange
+		undated []calRow
+	)
+	for _, story := range sortByStart(stories) {
+		if !story.DateStart().IsDefined() && !story.DateEnd().IsDefined() {
+			undated = append(undated, calRow{issue: story, depth: 1, noDates: true})
+			continue
+		}
+		dr, err := utils.MakeDayRangeGentle(story.DateStart(), story.DateEnd())
+		rows = append(rows, calRow{
+			issue:   story,
+			dr:      dr,
+			err:     err,
+			depth:   1,
+			outside: !epicDr.ContainsRange(dr),
+		})
+		if span == nil {
+			span = dr
+		} else {
+			span = span.Union(dr)
+		}
+	}
+	rows = append(rows, undated...)
+	if span != nil {
+		rows = append(rows, calRow{
+			issue:   epic,
+			dr:      span,
+			depth:   1,
+			isSpan:  true,
+			outside: !epicDr.ContainsRange(span),
+		})
+	}
+	return
+}
+
+// sortByStart returns the issues sorted by start date (or end date
+// if there's no start date), with undated issues last.
+func sortByStart(issues myj.IssueList) myj.IssueList {
+	date := func(issue *myj.ResponseIssue) utils.Date {
+		if d := issue.DateStart(); d.IsDefined() {
+			return d
 		}
+		return issue.DateEnd()
 	}
+	result := append(myj.IssueList(nil), issues...)
+	sort.SliceStable(result, func(i, j int) bool {
+		di, dj := date(result[i]), date(result[j])
+		if di.IsDefined() != dj.IsDefined() {
+			return di.IsDefined()
+		}
+		return di.Before(dj)
+	})
+	return result
+}
+
+func dateErrors(errors []error) error {
 	if len(errors) == 0 {
 		return nil
 	}
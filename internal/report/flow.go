@@ -0,0 +1,146 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+)
+
+const (
+	// maxOutliers is the most outliers listed per group.
+	maxOutliers = 5
+	// histWidth is the width of the longest histogram bar.
+	histWidth = 40
+)
+
+// histBuckets are the lower bounds of histogram buckets in days.
+// Flow times are skewed, so the buckets grow.
+var histBuckets = []int{0, 1, 2, 4, 8, 16, 32, 64}
+
+// DoFlow writes lead and cycle time percentiles, a cycle time histogram,
+// the mean time in each status and the slowest issues, for each group.
+func DoFlow(w io.Writer, flows []myj.Flow, by GroupBy) {
+	groups := make(map[string][]myj.Flow)
+	for _, f := range flows {
+		name := groupName(f.Issue, by)
+		groups[name] = append(groups[name], f)
+	}
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		if i > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+		title := name
+		if title == "" {
+			title = "all"
+		}
+		doFlowGroup(w, title, groups[name])
+	}
+}
+
+func doFlowGroup(w io.Writer, name string, flows []myj.Flow) {
+	_, _ = fmt.Fprintf(w, "%s (%d issues)\n", name, len(flows))
+	var lead, cycle []int
+	for _, f := range flows {
+		lead = append(lead, f.LeadDays)
+		if f.CycleDays >= 0 {
+			cycle = append(cycle, f.CycleDays)
+		}
+	}
+	sort.Ints(lead)
+	sort.Ints(cycle)
+	_, _ = fmt.Fprintf(w, "  %-12s %5s %5s %5s %5s\n", "days", "P50", "P85", "P95", "max")
+	for _, x := range []struct {
+		name string
+		days []int
+	}{{"lead time", lead}, {"cycle time", cycle}} {
+		if len(x.days) == 0 {
+			_, _ = fmt.Fprintf(w, "  %-12s %5s\n", x.name, "-")
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "  %-12s %5d %5d %5d %5d\n", x.name,
+			percentile(x.days, 50), percentile(x.days, 85),
+			percentile(x.days, 95), x.days[len(x.days)-1])
+	}
+
+	if len(cycle) > 0 {
+		_, _ = fmt.Fprintln(w, "  cycle time histogram")
+		writeHistogram(w, cycle)
+	}
+
+	total := make(map[string]int)
+	for _, f := range flows {
+		for s, d := range f.DaysIn {
+			total[s] += d
+		}
+	}
+	statuses := make([]string, 0, len(total))
+	for s := range total {
+		statuses = append(statuses, s)
+	}
+	sort.Strings(statuses)
+	var parts []string
+	for _, s := range statuses {
+		parts = append(parts, fmt.Sprintf("%s %.1f", s, float64(total[s])/float64(len(flows))))
+	}
+	_, _ = fmt.Fprintf(w, "  mean days in status: %s\n", strings.Join(parts, ", "))
+
+	p95 := percentile(lead, 95)
+	var slow []myj.Flow
+	for _, f := range flows {
+		if f.LeadDays > p95 {
+			slow = append(slow, f)
+		}
+	}
+	sort.Slice(slow, func(i, j int) bool { return slow[i].LeadDays > slow[j].LeadDays })
+	if len(slow) > maxOutliers {
+		slow = slow[:maxOutliers]
+	}
+	if len(slow) > 0 {
+		_, _ = fmt.Fprintln(w, "  outliers (lead time over P95)")
+		for _, f := range slow {
+			_, _ = fmt.Fprintf(w, "    %s %4dd  %s\n",
+				f.Issue.MyKey, f.LeadDays, f.Issue.MySummary())
+		}
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []int, p int) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := (p*len(sorted)+99)/100 - 1
+	return sorted[max(0, min(i, len(sorted)-1))]
+}
+
+func writeHistogram(w io.Writer, values []int) {
+	counts := make([]int, len(histBuckets))
+	for _, v := range values {
+		i := sort.SearchInts(histBuckets, v+1) - 1
+		counts[i]++
+	}
+	biggest := 0
+	for _, c := range counts {
+		biggest = max(biggest, c)
+	}
+	for i, c := range counts {
+		label := fmt.Sprintf("%d+", histBuckets[i])
+		if i+1 < len(histBuckets) {
+			if hi := histBuckets[i+1] - 1; hi == histBuckets[i] {
+				label = fmt.Sprint(hi)
+			} else {
+				label = fmt.Sprintf("%d-%d", histBuckets[i], hi)
+			}
+		}
+		_, _ = fmt.Fprintf(w, "    %6s %s %d\n",
+			label, strings.Repeat("█", (c*histWidth+biggest-1)/biggest), c)
+	}
+}
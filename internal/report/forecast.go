@@ -0,0 +1,112 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// ForecastPercentiles are the percentiles reported by DoForecast.
+var ForecastPercentiles = []int{50, 85, 95}
+
+// Forecast holds the result of a Monte Carlo simulation of
+// the number of weeks needed to finish some number of stories.
+type Forecast struct {
+	Remaining int
+	// Throughput holds the stories finished per week, historically.
+	Throughput []int
+	Trials     int
+	// weeks holds the sorted results of all trials.
+	weeks []int
+}
+
+// MakeForecast simulates finishing the remaining stories many times,
+// each week drawing a throughput at random from history.
+func MakeForecast(
+	throughput []int, remaining, trials int, rng *rand.Rand) (*Forecast, error) {
+	total := 0
+	for _, n := range throughput {
+		total += n
+	}
+	if total == 0 {
+		return nil, fmt.Errorf(
+			"no stories were resolved in the last %d weeks, so no forecast",
+			len(throughput))
+	}
+	f := &Forecast{
+		Remaining:  remaining,
+		Throughput: throughput,
+		Trials:     trials,
+		weeks:      make([]int, trials),
+	}
+	for i := range f.weeks {
+		done, weeks := 0, 0
+		for done < remaining {
+			done += throughput[rng.IntN(len(throughput))]
+			weeks++
+		}
+		f.weeks[i] = weeks
+	}
+	sort.Ints(f.weeks)
+	return f, nil
+}
+
+// Weeks returns the number of weeks within which the given
+// percentage of trials finished.
+func (f *Forecast) Weeks(percentile int) int {
+	i := (percentile*len(f.weeks)+99)/100 - 1
+	return f.weeks[max(0, min(i, len(f.weeks)-1))]
+}
+
+// Date is the day the given percentage of trials finished by,
+// counting weeks from the given day.
+func (f *Forecast) Date(percentile int, from utils.Date) utils.Date {
+	return from.AddDays(7 * f.Weeks(percentile))
+}
+
+// DoForecast writes the forecast for an epic, comparing it to the
+// epic's end date.  If downstream isn't nil, it holds, for each
+// forecast percentile, the epics that would be pushed later.
+func DoForecast(
+	w io.Writer, epic *myj.ResponseIssue, f *Forecast,
+	downstream map[int][]myj.Shift) {
+	today := utils.Today()
+	_, _ = fmt.Fprintf(w, "%s %s\n", epic.MyKey, epic.MySummary())
+	_, _ = fmt.Fprintf(w, "  %d open stories; weekly throughput over the last %d weeks: %v\n",
+		f.Remaining, len(f.Throughput), f.Throughput)
+	_, _ = fmt.Fprintf(w, "  %d trials\n\n", f.Trials)
+	_, _ = fmt.Fprintf(w, "  %-10s %s\n", "end date", dateOrNone(epic.DateEnd()))
+	for _, p := range ForecastPercentiles {
+		d := f.Date(p, today)
+		note := ""
+		if end := epic.DateEnd(); end.IsDefined() {
+			if slack := d.DayCount(end) - 1; slack < 0 {
+				note = fmt.Sprintf("  %d days late", -slack)
+			}
+		}
+		_, _ = fmt.Fprintf(w, "  P%-9d %s%s\n", p, d, note)
+	}
+	if downstream == nil {
+		return
+	}
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "Downstream epics pushed later:")
+	for _, p := range ForecastPercentiles {
+		shifts := downstream[p]
+		_, _ = fmt.Fprintf(w, "  at P%d:", p)
+		if len(shifts) == 0 {
+			_, _ = fmt.Fprintln(w, " none")
+			continue
+		}
+		_, _ = fmt.Fprintln(w)
+		for _, s := range shifts {
+			_, _ = fmt.Fprintf(w, "    %s %s -> %s  %s\n",
+				s.Issue.MyKey, dateOrNone(s.Issue.DateEnd()), s.End,
+				s.Issue.MySummary())
+		}
+	}
+}
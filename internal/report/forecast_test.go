@@ -0,0 +1,34 @@
+package report
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeForecast(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	// Constant throughput leaves nothing to chance.
+	f, err := MakeForecast([]int{5, 5, 5}, 12, 100, rng)
+	assert.NoError(t, err)
+	for _, p := range ForecastPercentiles {
+		assert.Equal(t, 3, f.Weeks(p))
+	}
+
+	f, err = MakeForecast([]int{0, 2, 4, 10}, 20, 2000, rng)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, f.Weeks(50), f.Weeks(85))
+	assert.LessOrEqual(t, f.Weeks(85), f.Weeks(95))
+	// At best, ten a week.
+	assert.GreaterOrEqual(t, f.Weeks(50), 2)
+
+	// Nothing to do.
+	f, err = MakeForecast([]int{1}, 0, 10, rng)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, f.Weeks(95))
+
+	_, err = MakeForecast([]int{0, 0}, 3, 10, rng)
+	assert.Error(t, err)
+}
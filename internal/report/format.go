@@ -0,0 +1,45 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run github.com/dmarkham/enumer -linecomment -type=Format
+type Format int
+
+const (
+	FormatUnknown  Format = iota
+	FormatText            // text
+	FormatSvg             // svg
+	FormatHtml            // html
+	FormatDot             // dot
+	FormatMermaid         // mermaid
+	FormatPlantUml        // plantuml
+	FormatJson            // json
+	FormatMarkdown        // markdown
+)
+
+// ParseFormat returns the Format with the given name, failing if
+// it's not one of the allowed formats.
+func ParseFormat(s string, allowed ...Format) (Format, error) {
+	f, err := FormatString(s)
+	if err == nil {
+		for _, a := range allowed {
+			if f == a {
+				return f, nil
+			}
+		}
+	}
+	return FormatUnknown, fmt.Errorf(
+		"unknown format %q; use one of %s", s, FormatNames(allowed...))
+}
+
+// FormatNames returns the given formats as a string for use in help.
+func FormatNames(formats ...Format) string {
+	names := make([]string, len(formats))
+	for i := range formats {
+		names[i] = formats[i].String()
+	}
+	return strings.Join(names, "|")
+}
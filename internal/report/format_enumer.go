@@ -0,0 +1,106 @@
+// Code generated by "enumer -linecomment -type=Format"; DO NOT EDIT.
+
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _FormatName = "FormatUnknowntextsvghtmldotmermaidplantumljsonmarkdown"
+
+var _FormatIndex = [...]uint8{0, 13, 17, 20, 24, 27, 34, 42, 46, 54}
+
+const _FormatLowerName = "formatunknowntextsvghtmldotmermaidplantumljsonmarkdown"
+
+func (i Format) String() string {
+	if i < 0 || i >= Format(len(_FormatIndex)-1) {
+		return fmt.Sprintf("Format(%d)", i)
+	}
+	return _FormatName[_FormatIndex[i]:_FormatIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _FormatNoOp() {
+	var x [1]struct{}
+	_ = x[FormatUnknown-(0)]
+	_ = x[FormatText-(1)]
+	_ = x[FormatSvg-(2)]
+	_ = x[FormatHtml-(3)]
+	_ = x[FormatDot-(4)]
+	_ = x[FormatMermaid-(5)]
+	_ = x[FormatPlantUml-(6)]
+	_ = x[FormatJson-(7)]
+	_ = x[FormatMarkdown-(8)]
+}
+
+var _FormatValues = []Format{FormatUnknown, FormatText, FormatSvg, FormatHtml, FormatDot, FormatMermaid, FormatPlantUml, FormatJson, FormatMarkdown}
+
+var _FormatNameToValueMap = map[string]Format{
+	_FormatName[0:13]:       FormatUnknown,
+	_FormatLowerName[0:13]:  FormatUnknown,
+	_FormatName[13:17]:      FormatText,
+	_FormatLowerName[13:17]: FormatText,
+	_FormatName[17:20]:      FormatSvg,
+	_FormatLowerName[17:20]: FormatSvg,
+	_FormatName[20:24]:      FormatHtml,
+	_FormatLowerName[20:24]: FormatHtml,
+	_FormatName[24:27]:      FormatDot,
+	_FormatLowerName[24:27]: FormatDot,
+	_FormatName[27:34]:      FormatMermaid,
+	_FormatLowerName[27:34]: FormatMermaid,
+	_FormatName[34:42]:      FormatPlantUml,
+	_FormatLowerName[34:42]: FormatPlantUml,
+	_FormatName[42:46]:      FormatJson,
+	_FormatLowerName[42:46]: FormatJson,
+	_FormatName[46:54]:      FormatMarkdown,
+	_FormatLowerName[46:54]: FormatMarkdown,
+}
+
+var _FormatNames = []string{
+	_FormatName[0:13],
+	_FormatName[13:17],
+	_FormatName[17:20],
+	_FormatName[20:24],
+	_FormatName[24:27],
+	_FormatName[27:34],
+	_FormatName[34:42],
+	_FormatName[42:46],
+	_FormatName[46:54],
+}
+
+// FormatString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func FormatString(s string) (Format, error) {
+	if val, ok := _FormatNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _FormatNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Format values", s)
+}
+
+// FormatValues returns all values of the enum
+func FormatValues() []Format {
+	return _FormatValues
+}
+
+// FormatStrings returns a slice of all String values of the enum
+func FormatStrings() []string {
+	strs := make([]string, len(_FormatNames))
+	copy(strs, _FormatNames)
+	return strs
+}
+
+// IsAFormat returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Format) IsAFormat() bool {
+	for _, v := range _FormatValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
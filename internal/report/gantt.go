@@ -0,0 +1,280 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"time"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// Dimensions (in pixels) of the gantt chart.
+const (
+	svgDayWidth     = 6
+	svgRowHeight    = 22
+	svgBarHeight    = 14
+	svgHeaderHeight = 40
+	svgLabelWidth   = 480
+	svgRightMargin  = 160
+	svgFontSize     = 12
+	svgCharWidth    = 7 // rough average, used to truncate labels
+)
+
+// DoGanttHtml writes a self-contained HTML page holding
+// the chart made by DoGanttSvg.
+func DoGanttHtml(
+	w io.Writer,
+	epicMap map[myj.MyKey]*myj.ResponseIssue,
+	issueMap map[myj.MyKey]myj.IssueList,
+	p CalParams,
+) error {
+	title := html.EscapeString(p.ProjectName + " epics " + p.Outer.PrettyRange())
+	_, _ = fmt.Fprintf(w, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+  body { font-family: sans-serif; margin: 1em; }
+  h1 { font-size: 1.2em; }
+</style>
+</head>
+<body>
+<h1>%s</h1>
+`, title, title)
+	err := DoGanttSvg(w, epicMap, issueMap, p)
+	_, _ = fmt.Fprintln(w, "</body>")
+	_, _ = fmt.Fprintln(w, "</html>")
+	return err
+}
+
+// DoGanttSvg writes a gantt chart of the given epics as a standalone SVG
+// document, with the same rows as DoCal.  Bars are colored by status,
+// labeled by assignee, and connected by arrows from blockers to the epics
+// they block.  If issueMap isn't nil, stories are drawn under their epics.
+func DoGanttSvg(
+	w io.Writer,
+	epicMap map[myj.MyKey]*myj.ResponseIssue,
+	issueMap map[myj.MyKey]myj.IssueList,
+	p CalParams,
+) error {
+	rows, errors := makeCalRows(epicMap, issueMap, p.SortBy)
+	rows = withSections(rows, p.GroupBy)
+	g := ganttLayout{outer: p.Outer.RoundToMondayAndFriday()}
+	width := g.x(g.outer.End().AddDays(1)) + svgRightMargin
+	height := svgHeaderHeight + len(rows)*svgRowHeight + svgRowHeight
+	_, _ = fmt.Fprintf(w,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" `+
+			`viewBox="0 0 %d %d" font-family="sans-serif" font-size="%d">`+"\n",
+		width, height, width, height, svgFontSize)
+	_, _ = fmt.Fprintln(w, `<defs><marker id="arrow" viewBox="0 0 8 8" `+
+		`refX="8" refY="4" markerWidth="8" markerHeight="8" orient="auto">`+
+		`<path d="M0,0 L8,4 L0,8 z" fill="#555"/></marker></defs>`)
+	_, _ = fmt.Fprintf(w,
+		`<rect width="%d" height="%d" fill="white"/>`+"\n", width, height)
+	g.writeCalendar(w, height, p.Sprints)
+	rowOf := make(map[myj.MyKey]int, len(rows))
+	for i := range rows {
+		if rows[i].section != "" {
+			g.writeSection(w, i, rows[i].section)
+			continue
+		}
+		if !rows[i].isSpan {
+			rowOf[rows[i].issue.MyKey] = i
+		}
+		g.writeRow(w, i, &rows[i], p)
+	}
+	for i := range rows {
+		if rows[i].section != "" || rows[i].isSpan {
+			continue
+		}
+		for _, blocker := range rows[i].issue.DependsOn(p.DependencyLinkTypes) {
+			if j, ok := rowOf[blocker]; ok {
+				g.writeArrow(w, j, &rows[j], i, &rows[i])
+			}
+		}
+	}
+	g.writeToday(w, height)
+	_, _ = fmt.Fprintln(w, "</svg>")
+	return dateErrors(errors)
+}
+
+type ganttLayout struct {
+	outer *utils.DayRange
+}
+
+// x is the horizontal position of the start of the given day,
+// clamped to the chart.
+func (g *ganttLayout) x(d utils.Date) int {
+	n := g.outer.Start().DayCount(d) - 1
+	if n < 0 {
+		n = 0
+	}
+	if limit := g.outer.Start().DayCount(g.outer.End()); n > limit {
+		n = limit
+	}
+	return svgLabelWidth + n*svgDayWidth
+}
+
+// y is the vertical position of the top of the given row.
+func (g *ganttLayout) y(row int) int {
+	return svgHeaderHeight + row*svgRowHeight
+}
+
+func (g *ganttLayout) writeCalendar(
+	w io.Writer, height int, sprints []*utils.DayRange) {
+	top := svgHeaderHeight - 4
+	day := g.outer.Start()
+	for !day.After(g.outer.End()) {
+		x := g.x(day)
+		if day.Weekday() == time.Saturday {
+			_, _ = fmt.Fprintf(w,
+				`<rect x="%d" y="%d" width="%d" height="%d" fill="#f3f3f3"/>`+"\n",
+				x, top, 2*svgDayWidth, height-top)
+		}
+		if day.Day() == 1 || day.Equal(g.outer.Start()) {
+			_, _ = fmt.Fprintf(w,
+				`<text x="%d" y="14" font-weight="bold">%s</text>`+"\n",
+				x, day.Format("Jan 2006"))
+		}
+		if day.Weekday() == time.Monday {
+			_, _ = fmt.Fprintf(w,
+				`<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#e0e0e0"/>`+"\n",
+				x, top, x, height)
+			_, _ = fmt.Fprintf(w,
+				`<text x="%d" y="30" font-size="10" fill="#666">%d</text>`+"\n",
+				x+1, day.Day())
+		}
+		day = day.AddDays(1)
+	}
+	for _, s := range sprints {
+		if !g.outer.Contains(s.Start()) {
+			continue
+		}
+		x := g.x(s.Start())
+		_, _ = fmt.Fprintf(w,
+			`<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#8899bb" `+
+				`stroke-dasharray="4,3"><title>sprint %s</title></line>`+"\n",
+			x, 18, x, height, s.PrettyRange())
+	}
+}
+
+func (g *ganttLayout) writeSection(w io.Writer, i int, name string) {
+	y := g.y(i)
+	_, _ = fmt.Fprintf(w,
+		`<text x="4" y="%d" font-weight="bold">%s</text>`+"\n",
+		y+svgRowHeight-7, html.EscapeString(name))
+	_, _ = fmt.Fprintf(w,
+		`<line x1="0" y1="%d" x2="%d" y2="%d" stroke="#999"/>`+"\n",
+		y+svgRowHeight-2, g.x(g.outer.End().AddDays(1)), y+svgRowHeight-2)
+}
+
+func (g *ganttLayout) writeRow(w io.Writer, i int, row *calRow, p CalParams) {
+	issue := row.issue
+	y := g.y(i)
+	if (i/max(p.LineSetSize, 1))%2 == 1 {
+		_, _ = fmt.Fprintf(w,
+			`<rect x="0" y="%d" width="%d" height="%d" fill="#000" fill-opacity="0.03"/>`+"\n",
+			y, svgLabelWidth, svgRowHeight)
+	}
+	label := fmt.Sprintf("%5d %s", issue.MyKey.Num, issue.MySummary())
+	fill := "black"
+	if row.depth > 0 {
+		// Indent stories under their epic.
+		fill = "#444"
+		label = fmt.Sprintf("%5d    %s", issue.MyKey.Num, row.label())
+		if row.isSpan {
+			label = fmt.Sprintf("%5s    %s", "", row.label())
+		}
+	}
+	_, _ = fmt.Fprintf(w,
+		`<text x="4" y="%d" xml:space="preserve" fill="%s">%s</text>`+"\n",
+		y+svgRowHeight-7, fill,
+		html.EscapeString(utils.Ellipsis(
+			label, min(p.FieldSizeName, svgLabelWidth/svgCharWidth))))
+	if row.outside {
+		_, _ = fmt.Fprintf(w,
+			`<text x="%d" y="%d" fill="red" font-weight="bold">!`+
+				`<title>outside the epic's dates</title></text>`+"\n",
+			svgLabelWidth-10, y+svgRowHeight-7)
+	}
+	if row.noDates {
+		_, _ = fmt.Fprintf(w,
+			`<text x="%d" y="%d" font-style="italic" fill="#999">no dates</text>`+"\n",
+			svgLabelWidth+4, y+svgRowHeight-7)
+		return
+	}
+	x1 := g.x(row.dr.Start())
+	x2 := g.x(row.dr.End().AddDays(1))
+	if x2 <= x1 {
+		// Entirely outside the chart.
+		return
+	}
+	if row.isSpan {
+		_, _ = fmt.Fprintf(w,
+			`<rect x="%d" y="%d" width="%d" height="%d" fill="none" `+
+				`stroke="#3aa0b0" stroke-width="2"><title>stories span %s</title></rect>`+"\n",
+			x1, y+svgRowHeight/2-2, x2-x1, 4, row.dr.PrettyRange())
+		return
+	}
+	dash := ""
+	if row.err != nil {
+		dash = ` stroke-dasharray="3,2"`
+	}
+	_, _ = fmt.Fprintf(w,
+		`<rect x="%d" y="%d" width="%d" height="%d" rx="3" fill="%s" stroke="#666"%s>`,
+		x1, y+(svgRowHeight-svgBarHeight)/2, x2-x1, svgBarHeight,
+		myj.StatusColor(issue, myj.ColorKindSvg), dash)
+	_, _ = fmt.Fprintf(w, "<title>%s</title></rect>\n",
+		html.EscapeString(g.tooltip(row)))
+	if p.ShowAssignee && issue.AssigneeName() != "" {
+		_, _ = fmt.Fprintf(w,
+			`<text x="%d" y="%d" font-size="11" fill="#555">%s</text>`+"\n",
+			x2+4, y+svgRowHeight-7, html.EscapeString(issue.AssigneeName()))
+	}
+}
+
+func (g *ganttLayout) tooltip(row *calRow) string {
+	issue := row.issue
+	result := fmt.Sprintf("%s %s\n%s\n%s",
+		issue.MyKey, issue.MySummary(), row.dr.PrettyRange(), issue.StatusRaw())
+	if name := issue.AssigneeName(); name != "" {
+		result += " - " + name
+	}
+	if row.err != nil {
+		result += "\n" + row.err.Error()
+	}
+	return result
+}
+
+// writeArrow draws an arrow from the end of the blocker's bar
+// to the start of the blocked bar.
+func (g *ganttLayout) writeArrow(
+	w io.Writer, i int, blocker *calRow, j int, blocked *calRow) {
+	const jog = 6
+	x1 := g.x(blocker.dr.End().AddDays(1))
+	y1 := g.y(i) + svgRowHeight/2
+	x2 := g.x(blocked.dr.Start())
+	y2 := g.y(j) + svgRowHeight/2
+	_, _ = fmt.Fprintf(w,
+		`<path d="M%d,%d h%d V%d H%d" fill="none" stroke="#555" `+
+			`marker-end="url(#arrow)"><title>%s blocks %s</title></path>`+"\n",
+		x1, y1, jog, y2, x2,
+		blocker.issue.MyKey, blocked.issue.MyKey)
+}
+
+func (g *ganttLayout) writeToday(w io.Writer, height int) {
+	today := utils.Today()
+	if !g.outer.Contains(today) {
+		return
+	}
+	x := g.x(today) + svgDayWidth/2
+	_, _ = fmt.Fprintf(w,
+		`<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="red" stroke-width="1.5"/>`+"\n",
+		x, svgHeaderHeight-4, x, height)
+	_, _ = fmt.Fprintf(w,
+		`<text x="%d" y="%d" font-size="10" fill="red">today</text>`+"\n",
+		x+2, height-4)
+}
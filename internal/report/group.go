@@ -0,0 +1,121 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+)
+
+//go:generate go run github.com/dmarkham/enumer -linecomment -type=GroupBy
+type GroupBy int
+
+const (
+	GroupByNone     GroupBy = iota // none
+	GroupByLabel                   // label
+	GroupByAssignee                // assignee
+	GroupByStatus                  // status
+	GroupByEpic                    // epic
+	GroupByType                    // type
+)
+
+// ParseGroupBy returns the GroupBy with the given name, failing if
+// it's not one of the allowed values.
+func ParseGroupBy(s string, allowed ...GroupBy) (GroupBy, error) {
+	g, err := GroupByString(s)
+	if err == nil {
+		for _, a := range allowed {
+			if g == a {
+				return g, nil
+			}
+		}
+	}
+	return GroupByNone, fmt.Errorf(
+		"unknown grouping %q; use one of %s", s, GroupByNames(allowed...))
+}
+
+// GroupByNames returns the given values as a string for use in help.
+func GroupByNames(values ...GroupBy) string {
+	names := make([]string, len(values))
+	for i := range values {
+		names[i] = values[i].String()
+	}
+	return strings.Join(names, "|")
+}
+
+const (
+	noLabel    = "(no label)"
+	unassigned = "(unassigned)"
+)
+
+// groupName returns the name of the group holding the issue.
+// An issue with several labels is grouped under the first in sort order.
+func groupName(issue *myj.ResponseIssue, by GroupBy) string {
+	switch by {
+	case GroupByLabel:
+		if len(issue.Fields.Labels) == 0 {
+			return noLabel
+		}
+		labels := append([]string(nil), issue.Fields.Labels...)
+		sort.Strings(labels)
+		return labels[0]
+	case GroupByAssignee:
+		if name := issue.AssigneeName(); name != "" {
+			return name
+		}
+		return unassigned
+	case GroupByStatus:
+		return issue.StatusRaw()
+	case GroupByEpic:
+		if link, ok := issue.Fields.CustomEpicLink.(string); ok && link != "" {
+			return link
+		}
+		return noEpic
+	case GroupByType:
+		return issue.TypeRaw()
+	default:
+		return ""
+	}
+}
+
+type calGroup struct {
+	name string
+	rows []calRow
+}
+
+// groupRows splits the rows into groups sorted by group name, retaining
+// the order of rows within each group.  Nested rows stay with their epic.
+// With GroupByNone, there's just one group with an empty name.
+func groupRows(rows []calRow, by GroupBy) (result []calGroup) {
+	index := make(map[string]int)
+	var name string
+	for _, row := range rows {
+		if row.depth == 0 {
+			name = groupName(row.issue, by)
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(result)
+			index[name] = i
+			result = append(result, calGroup{name: name})
+		}
+		result[i].rows = append(result[i].rows, row)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return
+}
+
+// withSections returns the rows in the order given by groupRows, with
+// a section row (a row with no issue) at the start of each named group.
+func withSections(rows []calRow, by GroupBy) (result []calRow) {
+	for _, group := range groupRows(rows, by) {
+		if group.name != "" {
+			result = append(result, calRow{section: group.name})
+		}
+		result = append(result, group.rows...)
+	}
+	return
+}
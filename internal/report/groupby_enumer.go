@@ -0,0 +1,94 @@
+// Code generated by "enumer -linecomment -type=GroupBy"; DO NOT EDIT.
+
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _GroupByName = "nonelabelassigneestatusepictype"
+
+var _GroupByIndex = [...]uint8{0, 4, 9, 17, 23, 27, 31}
+
+const _GroupByLowerName = "nonelabelassigneestatusepictype"
+
+func (i GroupBy) String() string {
+	if i < 0 || i >= GroupBy(len(_GroupByIndex)-1) {
+		return fmt.Sprintf("GroupBy(%d)", i)
+	}
+	return _GroupByName[_GroupByIndex[i]:_GroupByIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _GroupByNoOp() {
+	var x [1]struct{}
+	_ = x[GroupByNone-(0)]
+	_ = x[GroupByLabel-(1)]
+	_ = x[GroupByAssignee-(2)]
+	_ = x[GroupByStatus-(3)]
+	_ = x[GroupByEpic-(4)]
+	_ = x[GroupByType-(5)]
+}
+
+var _GroupByValues = []GroupBy{GroupByNone, GroupByLabel, GroupByAssignee, GroupByStatus, GroupByEpic, GroupByType}
+
+var _GroupByNameToValueMap = map[string]GroupBy{
+	_GroupByName[0:4]:        GroupByNone,
+	_GroupByLowerName[0:4]:   GroupByNone,
+	_GroupByName[4:9]:        GroupByLabel,
+	_GroupByLowerName[4:9]:   GroupByLabel,
+	_GroupByName[9:17]:       GroupByAssignee,
+	_GroupByLowerName[9:17]:  GroupByAssignee,
+	_GroupByName[17:23]:      GroupByStatus,
+	_GroupByLowerName[17:23]: GroupByStatus,
+	_GroupByName[23:27]:      GroupByEpic,
+	_GroupByLowerName[23:27]: GroupByEpic,
+	_GroupByName[27:31]:      GroupByType,
+	_GroupByLowerName[27:31]: GroupByType,
+}
+
+var _GroupByNames = []string{
+	_GroupByName[0:4],
+	_GroupByName[4:9],
+	_GroupByName[9:17],
+	_GroupByName[17:23],
+	_GroupByName[23:27],
+	_GroupByName[27:31],
+}
+
+// GroupByString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func GroupByString(s string) (GroupBy, error) {
+	if val, ok := _GroupByNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _GroupByNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to GroupBy values", s)
+}
+
+// GroupByValues returns all values of the enum
+func GroupByValues() []GroupBy {
+	return _GroupByValues
+}
+
+// GroupByStrings returns a slice of all String values of the enum
+func GroupByStrings() []string {
+	strs := make([]string, len(_GroupByNames))
+	copy(strs, _GroupByNames)
+	return strs
+}
+
+// IsAGroupBy returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i GroupBy) IsAGroupBy() bool {
+	for _, v := range _GroupByValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
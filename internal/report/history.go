@@ -0,0 +1,75 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// DoHistory writes a timeline of the changes to an issue's dates.
+// The issue must have been fetched with its changelog.
+func DoHistory(w io.Writer, issue *myj.ResponseIssue) {
+	_, _ = fmt.Fprintf(w, "%s %s\n", issue.MyKey, issue.MySummary())
+	changes := issue.DateChanges()
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(w, "  no date changes")
+		return
+	}
+	for _, c := range changes {
+		delta := ""
+		if c.Delta != 0 {
+			delta = fmt.Sprintf("%+dd", c.Delta)
+		}
+		_, _ = fmt.Fprintf(w, "  %s  %-22s  %10s -> %-10s %6s  %s\n",
+			c.When, c.Field, valueOrNone(c.From), valueOrNone(c.To),
+			delta, c.Author)
+	}
+	count, days := issue.Slips()
+	_, _ = fmt.Fprintf(w, "  end date slipped %d times, %d days in all\n", count, days)
+}
+
+// slipWidth is the number of end date changes shown in a sparkline.
+const slipWidth = 8
+
+// slipSparkline draws the days an issue's end date was pushed out,
+// most recent change on the right.
+func slipSparkline(issue *myj.ResponseIssue) string {
+	var deltas []int
+	for _, c := range issue.DateChanges() {
+		if c.Field == myj.CustomFieldTargetCompletionDate {
+			deltas = append(deltas, c.Delta)
+		}
+	}
+	return utils.Sparkline(deltas, slipWidth)
+}
+
+// DoSlips writes the given epics ranked by the total number of days
+// their end dates were pushed out.  The epics must have been fetched
+// with their changelogs.
+func DoSlips(w io.Writer, epicMap map[myj.MyKey]*myj.ResponseIssue) {
+	type slip struct {
+		epic        *myj.ResponseIssue
+		count, days int
+	}
+	var slips []slip
+	for _, epic := range epicMap {
+		count, days := epic.Slips()
+		slips = append(slips, slip{epic: epic, count: count, days: days})
+	}
+	sort.Slice(slips, func(i, j int) bool {
+		if slips[i].days != slips[j].days {
+			return slips[i].days > slips[j].days
+		}
+		return slips[i].epic.MyKey.Num < slips[j].epic.MyKey.Num
+	})
+	_, _ = fmt.Fprintf(w, "%5s %5s %5s  %-*s  %s\n",
+		"epic", "slips", "days", slipWidth, "history", "summary")
+	for _, s := range slips {
+		_, _ = fmt.Fprintf(w, "%5d %5d %5d  %s  %s\n",
+			s.epic.MyKey.Num, s.count, s.days,
+			slipSparkline(s.epic), s.epic.MySummary())
+	}
+}
@@ -0,0 +1,36 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/monopole/gojira/internal/myj"
+)
+
+// DoLint writes lint findings as text or json.
+func DoLint(w io.Writer, findings []myj.LintFinding, f Format) error {
+	if f == FormatJson {
+		if findings == nil {
+			// Write an empty list rather than null.
+			findings = []myj.LintFinding{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(findings)
+	}
+	counts := make(map[myj.LintSeverity]int)
+	for _, x := range findings {
+		counts[x.Severity]++
+		fix := ""
+		if x.Fixable {
+			fix = " (fixable)"
+		}
+		_, _ = fmt.Fprintf(w, "%-12s %-7s %-19s %s%s\n",
+			x.Key, x.Severity, x.Rule, x.Message, fix)
+	}
+	_, _ = fmt.Fprintf(w, "%d errors, %d warnings, %d infos\n",
+		counts[myj.LintSeverityError], counts[myj.LintSeverityWarning],
+		counts[myj.LintSeverityInfo])
+	return nil
+}
@@ -0,0 +1,124 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// loadCellWidth is the width of one week's column.
+const loadCellWidth = 4
+
+// loadRow counts, for each week, the issues an assignee owns
+// whose dates overlap that week.
+type loadRow struct {
+	name   string
+	counts []int
+	// undated is the number of issues skipped for lack of dates.
+	undated int
+}
+
+// makeLoadRows returns a row per assignee, sorted by name with
+// unassigned work last, and the Monday starting each week.
+func makeLoadRows(
+	issues myj.IssueList, dr *utils.DayRange) ([]*loadRow, []utils.Date) {
+	var weeks []utils.Date
+	for d := dr.Start().BackToMonday(); !d.After(dr.End()); d = d.AddDays(7) {
+		weeks = append(weeks, d)
+	}
+	rows := make(map[string]*loadRow)
+	for _, issue := range issues {
+		name := issue.AssigneeName()
+		if name == "" {
+			name = unassigned
+		}
+		row, ok := rows[name]
+		if !ok {
+			row = &loadRow{name: name, counts: make([]int, len(weeks))}
+			rows[name] = row
+		}
+		start, end := issue.DateStart(), issue.DateEnd()
+		if !start.IsDefined() || !end.IsDefined() {
+			row.undated++
+			continue
+		}
+		for i, monday := range weeks {
+			sunday := monday.AddDays(6)
+			if !start.After(sunday) && !end.Before(monday) {
+				row.counts[i]++
+			}
+		}
+	}
+	result := make([]*loadRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if (result[i].name == unassigned) != (result[j].name == unassigned) {
+			return result[j].name == unassigned
+		}
+		return result[i].name < result[j].name
+	})
+	return result, weeks
+}
+
+// loadColor picks a terminal color for the given count.
+func loadColor(count int) string {
+	switch {
+	case count == 0:
+		return utils.TerminalColorGray
+	case count <= 2:
+		return utils.TerminalColorGreen
+	case count <= 4:
+		return utils.TerminalColorYellow
+	default:
+		return utils.TerminalColorRed
+	}
+}
+
+// DoLoad writes a heat map with a row per assignee and a column per week,
+// each cell holding the number of the assignee's epics and stories whose
+// dates overlap the week.
+func DoLoad(
+	w io.Writer, issues myj.IssueList, dr *utils.DayRange, useColor bool) {
+	rows, weeks := makeLoadRows(issues, dr)
+	nameWidth := len(unassigned)
+	for _, row := range rows {
+		nameWidth = max(nameWidth, len(row.name))
+	}
+	var months, days strings.Builder
+	prevMonth := -1
+	for _, monday := range weeks {
+		m := ""
+		if int(monday.Month()) != prevMonth {
+			m = monday.Format("Jan")
+			prevMonth = int(monday.Month())
+		}
+		months.WriteString(fmt.Sprintf("%*s", loadCellWidth, m))
+		days.WriteString(fmt.Sprintf("%*d", loadCellWidth, monday.Day()))
+	}
+	_, _ = fmt.Fprintf(w, "%-*s %s\n", nameWidth, "", strings.TrimRight(months.String(), " "))
+	_, _ = fmt.Fprintf(w, "%-*s %s\n", nameWidth, "", days.String())
+	for _, row := range rows {
+		var b strings.Builder
+		for _, count := range row.counts {
+			cell := fmt.Sprintf("%*d", loadCellWidth, count)
+			if count == 0 {
+				cell = strings.Repeat(" ", loadCellWidth-1) + "·"
+			}
+			if useColor {
+				cell = loadColor(count) + cell + utils.TerminalReset
+			}
+			b.WriteString(cell)
+		}
+		line := fmt.Sprintf("%-*s %s", nameWidth, row.name, b.String())
+		if row.undated > 0 {
+			line += fmt.Sprintf("  (%d undated)", row.undated)
+		}
+		_, _ = fmt.Fprintln(w, line)
+	}
+}
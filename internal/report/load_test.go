@@ -0,0 +1,55 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// loadIssue makes an issue assigned to the named user, running over
+// the given days of March 2026; zero means no date.
+func loadIssue(name string, start, end int) *myj.ResponseIssue {
+	march := func(d int) string {
+		if d == 0 {
+			return ""
+		}
+		return utils.MakeDate(2026, time.March, d).JiraFormat()
+	}
+	ri := &myj.ResponseIssue{}
+	ri.Fields.Assignee.DisplayName = name
+	ri.Fields.CustomStartDate = march(start)
+	ri.Fields.CustomTargetCompletionDate = march(end)
+	return ri
+}
+
+func TestMakeLoadRows(t *testing.T) {
+	// Wednesday the 4th to Sunday the 22nd; weeks start on Mondays.
+	dr, err := utils.MakeDayRangeGentle(
+		utils.MakeDate(2026, time.March, 4), utils.MakeDate(2026, time.March, 22))
+	assert.NoError(t, err)
+	ldap := loadIssue("", 9, 9)
+	ldap.Fields.Assignee.Name = "aaron"
+	rows, weeks := makeLoadRows(myj.IssueList{
+		loadIssue("", 2, 22),
+		loadIssue("bob", 1, 1),
+		loadIssue("alice", 3, 10),
+		loadIssue("bob", 0, 10),
+		loadIssue("alice", 16, 16),
+		ldap,
+	}, dr)
+	assert.Equal(t, []utils.Date{
+		utils.MakeDate(2026, time.March, 2),
+		utils.MakeDate(2026, time.March, 9),
+		utils.MakeDate(2026, time.March, 16),
+	}, weeks)
+	assert.Equal(t, []*loadRow{
+		{name: "aaron", counts: []int{0, 1, 0}},
+		{name: "alice", counts: []int{1, 1, 1}},
+		// Bob's one dated issue ends the Sunday before the first week.
+		{name: "bob", counts: []int{0, 0, 0}, undated: 1},
+		{name: unassigned, counts: []int{1, 1, 1}},
+	}, rows)
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// DoMermaidGantt writes the epic schedule as a mermaid gantt chart,
+// suitable for embedding in markdown in a ```mermaid block.
+// See https://mermaid.js.org/syntax/gantt.html
+//
+// An epic blocked by other epics in the chart is drawn to start after
+// them, keeping its duration; other epics are drawn at their dates.
+// Stories, if any, follow their epic.
+// Tasks are marked done, active (in progress) or crit (past their end
+// date, but not done).
+func DoMermaidGantt(
+	w io.Writer,
+	epicMap map[myj.MyKey]*myj.ResponseIssue,
+	issueMap map[myj.MyKey]myj.IssueList,
+	p CalParams,
+) error {
+	rows, errors := makeCalRows(epicMap, issueMap, p.SortBy)
+	inChart := make(map[myj.MyKey]bool, len(rows))
+	for _, row := range rows {
+		inChart[row.issue.MyKey] = true
+	}
+	today := utils.Today()
+	_, _ = fmt.Fprintln(w, "gantt")
+	_, _ = fmt.Fprintf(w, "  title %s epics\n", p.ProjectName)
+	_, _ = fmt.Fprintln(w, "  dateFormat YYYY-MM-DD")
+	_, _ = fmt.Fprintf(w, "  axisFormat %s\n", "%b %d")
+	for _, group := range groupRows(rows, p.GroupBy) {
+		name := group.name
+		if name == "" {
+			name = p.ProjectName
+		}
+		_, _ = fmt.Fprintf(w, "  section %s\n", mermaidText(name))
+		for _, row := range group.rows {
+			if row.isSpan || row.noDates {
+				continue
+			}
+			issue := row.issue
+			fields := mermaidTags(issue, today)
+			fields = append(fields, issue.MyKey.Id())
+			var after []string
+			for _, k := range issue.DependsOn(p.DependencyLinkTypes) {
+				if inChart[k] {
+					after = append(after, k.Id())
+				}
+			}
+			if len(after) > 0 {
+				fields = append(fields,
+					"after "+strings.Join(after, " "),
+					strconv.Itoa(row.dr.Start().DayCount(row.dr.End()))+"d")
+			} else {
+				// Mermaid end dates are exclusive.
+				fields = append(fields,
+					row.dr.Start().JiraFormat(),
+					row.dr.End().AddDays(1).JiraFormat())
+			}
+			label := fmt.Sprintf("%d %s", issue.MyKey.Num, issue.MySummary())
+			if row.depth > 0 {
+				label = "- " + label
+			}
+			if p.ShowAssignee && issue.AssigneeName() != "" {
+				label += " (" + issue.AssigneeName() + ")"
+			}
+			_, _ = fmt.Fprintf(w, "  %s :%s\n",
+				mermaidText(utils.Ellipsis(label, p.FieldSizeName)),
+				strings.Join(fields, ", "))
+		}
+	}
+	return dateErrors(errors)
+}
+
+func mermaidTags(issue *myj.ResponseIssue, today utils.Date) (tags []string) {
+	if issue.IsLate(today) {
+		tags = append(tags, "crit")
+	}
+	if issue.SeemsDone() {
+		tags = append(tags, "done")
+	} else if issue.IsInProgress() {
+		tags = append(tags, "active")
+	}
+	return
+}
+
+// mermaidText removes characters that have meaning in a gantt line.
+func mermaidText(s string) string {
+	return strings.NewReplacer(":", " -", "#", "", ";", ",").Replace(s)
+}
@@ -0,0 +1,72 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+)
+
+// queriedIssue is an issue as written in json.
+type queriedIssue struct {
+	Key      string   `json:"key"`
+	Type     string   `json:"type"`
+	Status   string   `json:"status"`
+	Assignee string   `json:"assignee,omitempty"`
+	Labels   []string `json:"labels,omitempty"`
+	Start    string   `json:"start,omitempty"`
+	End      string   `json:"end,omitempty"`
+	Epic     string   `json:"epic,omitempty"`
+	Summary  string   `json:"summary"`
+}
+
+// DoIssues writes the issues found by a query as text, json or markdown.
+// The text lines are those of the epic export, but with no epics above
+// the stories, so the import won't read them.
+func DoIssues(
+	w io.Writer, issues []myj.ResponseIssue, f Format,
+	getEpicLink func(*myj.ResponseIssue) myj.MyKey) error {
+	switch f {
+	case FormatJson:
+		result := make([]queriedIssue, len(issues))
+		for i := range issues {
+			ri := &issues[i]
+			result[i] = queriedIssue{
+				Key:      ri.Key,
+				Type:     ri.TypeRaw(),
+				Status:   ri.StatusRaw(),
+				Assignee: ri.AssigneeLdap(),
+				Labels:   ri.Fields.Labels,
+				Summary:  ri.MySummary(),
+			}
+			if d := ri.DateStart(); d.IsDefined() {
+				result[i].Start = d.String()
+			}
+			if d := ri.DateEnd(); d.IsDefined() {
+				result[i].End = d.String()
+			}
+			if k := getEpicLink(ri); k.Num < myj.UnknownEpicBase {
+				result[i].Epic = k.String()
+			}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	case FormatMarkdown:
+		_, _ = fmt.Fprintln(w, "| Key | Type | Status | Assignee | Summary |")
+		_, _ = fmt.Fprintln(w, "|-----|------|--------|----------|---------|")
+		for i := range issues {
+			ri := &issues[i]
+			_, _ = fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n",
+				ri.Key, ri.TypeRaw(), ri.StatusRaw(), ri.AssigneeLdap(),
+				strings.ReplaceAll(ri.MySummary(), "|", `\|`))
+		}
+	default:
+		for i := range issues {
+			issues[i].SpewParsable(w, true, 0)
+		}
+	}
+	return nil
+}
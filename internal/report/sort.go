@@ -0,0 +1,51 @@
+package report
+
+import (
+	"sort"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+//go:generate go run github.com/dmarkham/enumer -linecomment -type=SortBy
+type SortBy int
+
+const (
+	SortByStart    SortBy = iota // start
+	SortByEnd                    // end
+	SortByKey                    // key
+	SortByAssignee               // assignee
+)
+
+// sortedEpicKeys returns the keys of the given epics in the given order.
+// Ties are broken by start date.
+func sortedEpicKeys(
+	epicMap map[myj.MyKey]*myj.ResponseIssue, by SortBy) myj.KeyList {
+	keys := myj.GetSortedKeys(epicMap)
+	switch by {
+	case SortByEnd:
+		end := func(i int) utils.Date {
+			if d := epicMap[keys[i].MyKey].DateEnd(); d.IsDefined() {
+				return d
+			}
+			return utils.Today()
+		}
+		sort.SliceStable(keys, func(i, j int) bool {
+			return end(i).Before(end(j))
+		})
+	case SortByKey:
+		sort.SliceStable(keys, keys.Less2)
+	case SortByAssignee:
+		// Unassigned epics go last.
+		name := func(i int) string {
+			if n := epicMap[keys[i].MyKey].AssigneeName(); n != "" {
+				return n
+			}
+			return "\uffff"
+		}
+		sort.SliceStable(keys, func(i, j int) bool {
+			return name(i) < name(j)
+		})
+	}
+	return keys
+}
@@ -0,0 +1,86 @@
+// Code generated by "enumer -linecomment -type=SortBy"; DO NOT EDIT.
+
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _SortByName = "startendkeyassignee"
+
+var _SortByIndex = [...]uint8{0, 5, 8, 11, 19}
+
+const _SortByLowerName = "startendkeyassignee"
+
+func (i SortBy) String() string {
+	if i < 0 || i >= SortBy(len(_SortByIndex)-1) {
+		return fmt.Sprintf("SortBy(%d)", i)
+	}
+	return _SortByName[_SortByIndex[i]:_SortByIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _SortByNoOp() {
+	var x [1]struct{}
+	_ = x[SortByStart-(0)]
+	_ = x[SortByEnd-(1)]
+	_ = x[SortByKey-(2)]
+	_ = x[SortByAssignee-(3)]
+}
+
+var _SortByValues = []SortBy{SortByStart, SortByEnd, SortByKey, SortByAssignee}
+
+var _SortByNameToValueMap = map[string]SortBy{
+	_SortByName[0:5]:        SortByStart,
+	_SortByLowerName[0:5]:   SortByStart,
+	_SortByName[5:8]:        SortByEnd,
+	_SortByLowerName[5:8]:   SortByEnd,
+	_SortByName[8:11]:       SortByKey,
+	_SortByLowerName[8:11]:  SortByKey,
+	_SortByName[11:19]:      SortByAssignee,
+	_SortByLowerName[11:19]: SortByAssignee,
+}
+
+var _SortByNames = []string{
+	_SortByName[0:5],
+	_SortByName[5:8],
+	_SortByName[8:11],
+	_SortByName[11:19],
+}
+
+// SortByString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func SortByString(s string) (SortBy, error) {
+	if val, ok := _SortByNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _SortByNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to SortBy values", s)
+}
+
+// SortByValues returns all values of the enum
+func SortByValues() []SortBy {
+	return _SortByValues
+}
+
+// SortByStrings returns a slice of all String values of the enum
+func SortByStrings() []string {
+	strs := make([]string, len(_SortByNames))
+	copy(strs, _SortByNames)
+	return strs
+}
+
+// IsASortBy returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i SortBy) IsASortBy() bool {
+	for _, v := range _SortByValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+// DoWeekly writes a status report per epic, as markdown or html.
+// An epic is at risk if its end date has passed but it's not done.
+func DoWeekly(
+	w io.Writer, project string, weeks []*myj.EpicWeek,
+	dr *utils.DayRange, f Format) {
+	d := docWriter{w: w, html: f == FormatHtml}
+	title := fmt.Sprintf("%s status, %s", project, dr.PrettyRange())
+	d.begin(title)
+	d.heading(1, title)
+	today := utils.Today()
+	for _, wk := range weeks {
+		epic := wk.Epic
+		d.heading(2, epic.MyKey.String()+" "+epic.MySummary())
+		if epic.IsLate(today) {
+			d.warn(fmt.Sprintf("At risk: target date %s has passed, status is %s.",
+				epic.DateEnd(), epic.StatusRaw()))
+		}
+		d.para(fmt.Sprintf("Status %s, dates %s to %s.",
+			epic.StatusRaw(), dateOrNone(epic.DateStart()), dateOrNone(epic.DateEnd())))
+		d.list("Completed", storyLines(wk.Completed, nil))
+		d.list("In progress", storyLines(wk.InProgress, nil))
+		d.list("Newly blocked", storyLines(wk.NewlyBlocked, wk.Blockers))
+		var changes []string
+		for _, c := range wk.DateChanges {
+			changes = append(changes, fmt.Sprintf("%s: %s changed from %s to %s by %s",
+				c.When, c.Field, valueOrNone(c.From), valueOrNone(c.To), c.Author))
+		}
+		d.list("Date changes", changes)
+	}
+	d.end()
+}
+
+// storyLines describes the stories, with their blockers if any are given.
+func storyLines(stories myj.IssueList, blockers map[myj.MyKey][]myj.MyKey) []string {
+	result := make([]string, len(stories))
+	for i, s := range stories {
+		result[i] = s.MyKey.String() + " " + s.MySummary()
+		if keys := blockers[s.MyKey]; len(keys) > 0 {
+			names := make([]string, len(keys))
+			for j, k := range keys {
+				names[j] = k.String()
+			}
+			result[i] += " (blocked by " + strings.Join(names, ", ") + ")"
+		}
+	}
+	return result
+}
+
+func dateOrNone(d utils.Date) string {
+	if !d.IsDefined() {
+		return "(none)"
+	}
+	return d.String()
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+// docWriter writes a simple document as either markdown or html.
+type docWriter struct {
+	w    io.Writer
+	html bool
+}
+
+func (d *docWriter) begin(title string) {
+	if !d.html {
+		return
+	}
+	_, _ = fmt.Fprintf(d.w, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+  body { font-family: sans-serif; margin: 1em; }
+</style>
+</head>
+<body>
+`, html.EscapeString(title))
+}
+
+func (d *docWriter) end() {
+	if !d.html {
+		return
+	}
+	_, _ = fmt.Fprintln(d.w, "</body>")
+	_, _ = fmt.Fprintln(d.w, "</html>")
+}
+
+func (d *docWriter) heading(level int, s string) {
+	if d.html {
+		_, _ = fmt.Fprintf(d.w, "<h%d>%s</h%d>\n", level, html.EscapeString(s), level)
+		return
+	}
+	_, _ = fmt.Fprintf(d.w, "%s %s\n\n", strings.Repeat("#", level), s)
+}
+
+func (d *docWriter) para(s string) {
+	if d.html {
+		_, _ = fmt.Fprintf(d.w, "<p>%s</p>\n", html.EscapeString(s))
+		return
+	}
+	_, _ = fmt.Fprintf(d.w, "%s\n\n", s)
+}
+
+func (d *docWriter) warn(s string) {
+	if d.html {
+		_, _ = fmt.Fprintf(d.w, "<p style=\"color:red\"><b>%s</b></p>\n", html.EscapeString(s))
+		return
+	}
+	_, _ = fmt.Fprintf(d.w, "**%s**\n\n", s)
+}
+
+// list writes a titled list, or nothing if the list is empty.
+func (d *docWriter) list(title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	title = fmt.Sprintf("%s (%d)", title, len(items))
+	if d.html {
+		_, _ = fmt.Fprintf(d.w, "<p><b>%s</b></p>\n<ul>\n", html.EscapeString(title))
+		for _, item := range items {
+			_, _ = fmt.Fprintf(d.w, "  <li>%s</li>\n", html.EscapeString(item))
+		}
+		_, _ = fmt.Fprintln(d.w, "</ul>")
+		return
+	}
+	_, _ = fmt.Fprintf(d.w, "**%s**\n\n", title)
+	for _, item := range items {
+		_, _ = fmt.Fprintf(d.w, "- %s\n", item)
+	}
+	_, _ = fmt.Fprintln(d.w)
+}
@@ -16,8 +16,8 @@ import (
 type ParsedIssue struct {
 	Proj      string
 	Num       int
-	Type      myj.IssueType
-	Status    myj.IssueStatus
+	Type      string
+	Status    string
 	RawLabels []string
 	Start     utils.Date
 	End       utils.Date
@@ -38,14 +38,48 @@ func UnSpewEpics(fs afero.Fs, path string) (
 	if err != nil {
 		return
 	}
-	for _, line := range bytes.Split(data, []byte("\n")) {
+	result, _, err = ParseEpics(data)
+	return
+}
+
+// LineError is a parse error, with the (one-based) number
+// of the offending line.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ParseEpics parses data in the form written by SpewEpics, returning
+// along with each parsed line its (one-based) line number.
+// Lines starting with '#' are comments.
+func ParseEpics(data []byte) (
+	result []*ParsedJiraLine,
+	lineNums []int,
+	err error) {
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		if bytes.HasPrefix(bytes.TrimSpace(line), []byte("#")) {
+			continue
+		}
 		var issue *ParsedJiraLine
 		issue, err = parseLine(line)
 		if err != nil {
-			return // TODO: return err
+			return nil, nil, &LineError{Line: i + 1, Err: err}
 		}
 		if issue != nil {
+			if len(result) == 0 && !issue.IsEpic {
+				return nil, nil, &LineError{
+					Line: i + 1, Err: fmt.Errorf("the first issue should be an epic")}
+			}
 			result = append(result, issue)
+			lineNums = append(lineNums, i+1)
 		}
 	}
 	return
@@ -75,18 +109,23 @@ func parseLine(line []byte) (*ParsedJiraLine, error) {
 		return nil, fme("issue number", arg, line)
 	}
 
-	var iType myj.IssueType
-	arg = match[3]
-	iType, err = myj.IssueTypeString(arg)
-	if err != nil {
-		return nil, fme("type", arg, line)
+	// Types and statuses vary by project, so any name is accepted here,
+	// and jira rejects the ones it doesn't know.  Well-known names are
+	// put in their usual case.
+	iType := strings.TrimSpace(match[3])
+	if iType == "" {
+		return nil, fme("type", match[3], line)
+	}
+	if t, err := myj.IssueTypeString(iType); err == nil {
+		iType = t.String()
 	}
 
-	arg = match[4]
-	var status myj.IssueStatus
-	status, err = myj.IssueStatusString(arg)
-	if err != nil {
-		return nil, fme("status", arg, line)
+	status := strings.TrimSpace(match[4])
+	if status == "" {
+		return nil, fme("status", match[4], line)
+	}
+	if s, err := myj.IssueStatusString(status); err == nil {
+		status = s.String()
 	}
 
 	arg = match[5]
@@ -181,9 +220,9 @@ func makeIssueRecord(key myj.MyKey, issue *ParsedIssue) *myj.ResponseIssue {
 				Summary: issue.Summary,
 			},
 			MiscIssueFields: myj.MiscIssueFields{
-				IssueType:  myj.IssueTypeR{Name: issue.Type.String()},
+				IssueType:  myj.IssueTypeR{Name: issue.Type},
 				Resolution: myj.ResolutionDetails{},
-				Status:     myj.StatusDetails{Name: issue.Status.String()},
+				Status:     myj.StatusDetails{Name: issue.Status},
 			},
 		},
 		Key:   key.String(),
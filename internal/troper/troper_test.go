@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,8 +26,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:      "BUDS",
 				Num:       598,
-				Type:      myj.IssueTypeEpic,
-				Status:    myj.IssueStatusBacklog,
+				Type:      myj.IssueTypeEpic.String(),
+				Status:    myj.IssueStatusBacklog.String(),
 				Start:     utils.MakeDate(2025, time.March, 3),
 				End:       utils.MakeDate(2025, time.April, 14),
 				RawLabels: []string{"blah"},
@@ -37,8 +38,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:      "BUDS",
 				Num:       607,
-				Type:      myj.IssueTypeTask,
-				Status:    myj.IssueStatusInProgress,
+				Type:      myj.IssueTypeTask.String(),
+				Status:    myj.IssueStatusInProgress.String(),
 				Start:     utils.MakeDate(2025, time.March, 6),
 				End:       utils.MakeDate(2025, time.March, 19),
 				RawLabels: []string{"blah"},
@@ -49,8 +50,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:    "CIA",
 				Num:     606,
-				Type:    myj.IssueTypeStory,
-				Status:  myj.IssueStatusDone,
+				Type:    myj.IssueTypeStory.String(),
+				Status:  myj.IssueStatusDone.String(),
 				Start:   utils.MakeDate(2025, time.March, 20),
 				End:     utils.MakeDate(2025, time.April, 17),
 				Summary: "Rigel rigel",
@@ -60,8 +61,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:      "BUDS",
 				Num:       608,
-				Type:      myj.IssueTypeTask,
-				Status:    myj.IssueStatusClosedWoAction,
+				Type:      myj.IssueTypeTask.String(),
+				Status:    myj.IssueStatusClosedWoAction.String(),
 				Start:     utils.MakeDate(2025, time.April, 15),
 				End:       utils.MakeDate(2025, time.June, 10),
 				RawLabels: []string{"blah"},
@@ -73,8 +74,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:    "BUDS",
 				Num:     597,
-				Type:    myj.IssueTypeEpic,
-				Status:  myj.IssueStatusInQueue,
+				Type:    myj.IssueTypeEpic.String(),
+				Status:  myj.IssueStatusInQueue.String(),
 				Start:   utils.MakeDate(2026, time.February, 5),
 				End:     utils.MakeDate(2026, time.February, 26),
 				Summary: "Vega vega",
@@ -85,8 +86,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:      "BUDS",
 				Num:       596,
-				Type:      myj.IssueTypeEpic,
-				Status:    myj.IssueStatusInQueue,
+				Type:      myj.IssueTypeEpic.String(),
+				Status:    myj.IssueStatusInQueue.String(),
 				Start:     utils.MakeDate(2025, time.April, 16),
 				End:       utils.MakeDate(2025, time.May, 21),
 				RawLabels: []string{"blah"},
@@ -97,8 +98,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:      "BUDS",
 				Num:       605,
-				Type:      myj.IssueTypeStory,
-				Status:    myj.IssueStatusReadyForDev,
+				Type:      myj.IssueTypeStory.String(),
+				Status:    myj.IssueStatusReadyForDev.String(),
 				Start:     utils.MakeDate(2025, time.June, 15),
 				End:       utils.MakeDate(2025, time.August, 10),
 				RawLabels: []string{"blah"},
@@ -109,8 +110,8 @@ func TestUnSpewEpics(t *testing.T) {
 			ParsedIssue: ParsedIssue{
 				Proj:      "BUDS",
 				Num:       604,
-				Type:      myj.IssueTypeTask,
-				Status:    myj.IssueStatusBacklog,
+				Type:      myj.IssueTypeTask.String(),
+				Status:    myj.IssueStatusBacklog.String(),
 				Start:     utils.MakeDate(2025, time.October, 15),
 				End:       utils.MakeDate(2025, time.December, 10),
 				RawLabels: []string{"ap8_-ple", "peach"},
@@ -195,3 +196,28 @@ func getEpicLink(ir *myj.ResponseIssue) (result myj.MyKey) {
 		Num:  9000,
 	}
 }
+
+func TestParseEpics(t *testing.T) {
+	const data = `
+# A comment, ignored.
+BUDS-597      [Epic]       (In Queue)       2026-Feb-05 2026-Feb-26   3w <> Vega vega
+  # Another.
+  BUDS-600    [Story]      (Backlog)        2026-Feb-09 2026-Feb-13   1w <> A story
+`
+	lines, nums, err := ParseEpics([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 5}, nums)
+	assert.Len(t, lines, 2)
+	assert.True(t, lines[0].IsEpic)
+	assert.Equal(t, 600, lines[1].Num)
+	assert.False(t, lines[1].IsEpic)
+
+	_, _, err = ParseEpics([]byte(data + "  BUDS-601 [Story] (Backlog) whenever\n"))
+	var le *LineError
+	assert.ErrorAs(t, err, &le)
+	assert.Equal(t, 6, le.Line)
+
+	_, _, err = ParseEpics([]byte(data[strings.Index(data, "  BUDS-600"):]))
+	assert.ErrorAs(t, err, &le)
+	assert.Equal(t, 1, le.Line)
+}
@@ -0,0 +1,130 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Keys other than plain characters.
+const (
+	keyUp    = "up"
+	keyDown  = "down"
+	keyLeft  = "left"
+	keyRight = "right"
+	keyEnter = "enter"
+	keyEsc   = "esc"
+)
+
+const (
+	ansiClear      = "\033[H\033[2J"
+	ansiHideCursor = "\033[?25l"
+	ansiShowCursor = "\033[?25h"
+	ansiReverse    = "\033[7m"
+)
+
+// terminal switches the controlling terminal between raw mode, for
+// reading single keys, and its original mode, for reading lines.
+// It uses stty rather than ioctls to avoid a dependency.
+type terminal struct {
+	tty   *os.File
+	saved string
+}
+
+func openTerminal() (*terminal, error) {
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		return nil, fmt.Errorf("need a terminal; %w", err)
+	}
+	t := &terminal{tty: tty}
+	if t.saved, err = t.stty("-g"); err != nil {
+		_ = tty.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *terminal) stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = t.tty
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("stty %v; %w", args, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func (t *terminal) raw() error {
+	_, err := t.stty("raw", "-echo")
+	return err
+}
+
+func (t *terminal) restore() error {
+	_, err := t.stty(t.saved)
+	return err
+}
+
+func (t *terminal) close() {
+	_ = t.restore()
+	_ = t.tty.Close()
+}
+
+// size returns the terminal's rows and columns, or a guess.
+func (t *terminal) size() (rows, cols int) {
+	rows, cols = 24, 80
+	out, err := t.stty("size")
+	if err != nil {
+		return
+	}
+	f := strings.Fields(out)
+	if len(f) != 2 {
+		return
+	}
+	if r, err := strconv.Atoi(f[0]); err == nil && r > 0 {
+		rows = r
+	}
+	if c, err := strconv.Atoi(f[1]); err == nil && c > 0 {
+		cols = c
+	}
+	return
+}
+
+// readKey reads one key press, in raw mode.
+func (t *terminal) readKey() (string, error) {
+	buf := make([]byte, 8)
+	n, err := t.tty.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	return parseKey(buf[:n]), nil
+}
+
+// parseKey names the key sent as the given bytes.
+func parseKey(b []byte) string {
+	switch {
+	case len(b) == 0:
+		return ""
+	case len(b) >= 3 && b[0] == 0x1b && b[1] == '[':
+		switch b[2] {
+		case 'A':
+			return keyUp
+		case 'B':
+			return keyDown
+		case 'C':
+			return keyRight
+		case 'D':
+			return keyLeft
+		}
+		return keyEsc
+	case b[0] == 0x1b:
+		return keyEsc
+	case b[0] == '\r' || b[0] == '\n':
+		return keyEnter
+	case b[0] == 3:
+		// ctrl-c
+		return "q"
+	}
+	return string(b[:1])
+}
@@ -0,0 +1,34 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKey(t *testing.T) {
+	tests := map[string]struct {
+		in   []byte
+		want string
+	}{
+		"nothing":   {in: nil, want: ""},
+		"letter":    {in: []byte("j"), want: "j"},
+		"space":     {in: []byte(" "), want: " "},
+		"pasted":    {in: []byte("abc"), want: "a"},
+		"up":        {in: []byte("\x1b[A"), want: keyUp},
+		"down":      {in: []byte("\x1b[B"), want: keyDown},
+		"right":     {in: []byte("\x1b[C"), want: keyRight},
+		"left":      {in: []byte("\x1b[D"), want: keyLeft},
+		"other csi": {in: []byte("\x1b[H"), want: keyEsc},
+		"esc":       {in: []byte{0x1b}, want: keyEsc},
+		"alt key":   {in: []byte("\x1bx"), want: keyEsc},
+		"return":    {in: []byte("\r"), want: keyEnter},
+		"newline":   {in: []byte("\n"), want: keyEnter},
+		"ctrl-c":    {in: []byte{3}, want: "q"},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseKey(tc.in))
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/monopole/gojira/internal/myj"
+	"github.com/monopole/gojira/internal/utils"
+)
+
+const help = "j/k move  enter expand  r rename  d dates  m move  s status  " +
+	"l label  a assign  c calendar  g reload  q quit"
+
+// row is one line of the list; an epic, or a story under an epic.
+type row struct {
+	issue  *myj.ResponseIssue
+	epic   *myj.ResponseIssue
+	isEpic bool
+}
+
+// App is a full screen view of the project's epics and their stories.
+type App struct {
+	jb       *myj.JiraBoss
+	term     *terminal
+	out      io.Writer
+	epics    []*myj.ResponseIssue
+	stories  map[myj.MyKey]myj.IssueList
+	expanded map[myj.MyKey]bool
+	cursor   int
+	// top is the index of the first row shown.
+	top     int
+	showCal bool
+	outer   *utils.DayRange
+	// msg is shown at the bottom of the screen until the next key.
+	msg   string
+	isErr bool
+}
+
+// Run shows the epics until the user quits.
+func Run(jb *myj.JiraBoss) error {
+	term, err := openTerminal()
+	if err != nil {
+		return err
+	}
+	defer term.close()
+	a := &App{
+		jb:       jb,
+		term:     term,
+		out:      os.Stdout,
+		expanded: make(map[myj.MyKey]bool),
+	}
+	a.outer, err = utils.MakeDayRangeSimple(utils.Today().BackToMonday().AddDays(-14), 90)
+	if err != nil {
+		return err
+	}
+	a.load()
+	if err = term.raw(); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(a.out, ansiHideCursor)
+	defer func() { _, _ = fmt.Fprint(a.out, ansiShowCursor+ansiClear) }()
+	for {
+		a.draw()
+		key, err := term.readKey()
+		if err != nil {
+			return err
+		}
+		a.msg, a.isErr = "", false
+		if key == "q" {
+			return nil
+		}
+		// What the writes log would garble the screen.
+		logged := captureStderr(func() { a.handle(key) })
+		if l := logMessage(logged); l != "" {
+			if a.msg != "" {
+				a.msg += "; "
+			}
+			a.msg += l
+		}
+	}
+}
+
+// captureStderr returns what f writes to stderr, rather than
+// letting it reach the terminal.
+func captureStderr(f func()) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		f()
+		return ""
+	}
+	saved := os.Stderr
+	os.Stderr = w
+	out := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		out <- string(data)
+	}()
+	func() {
+		defer func() { os.Stderr = saved }()
+		f()
+	}()
+	_ = w.Close()
+	result := <-out
+	_ = r.Close()
+	return result
+}
+
+// logMessage condenses logged text to one line for the bottom of the
+// screen; indented lines, e.g. the bodies of dry run writes, are dropped.
+func logMessage(logged string) string {
+	var kept []string
+	for _, line := range strings.Split(logged, "\n") {
+		if strings.TrimSpace(line) == "" ||
+			strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+		kept = append(kept, strings.TrimSpace(line))
+	}
+	return strings.Join(kept, "; ")
+}
+
+// load (re)reads the epics and their stories.
+func (a *App) load() {
+	em := a.jb.GetEpicsWithPlaceholder()
+	a.stories = a.jb.GetIssuesGroupedByEpic(em)
+	a.epics = a.epics[:0]
+	for _, epic := range em {
+		a.epics = append(a.epics, epic)
+	}
+	sort.Slice(a.epics, func(i, j int) bool {
+		return a.epics[i].MyKey.Less(a.epics[j].MyKey)
+	})
+}
+
+func (a *App) rows() []row {
+	var result []row
+	for _, epic := range a.epics {
+		result = append(result, row{issue: epic, epic: epic, isEpic: true})
+		if a.expanded[epic.MyKey] {
+			for _, s := range a.stories[epic.MyKey] {
+				result = append(result, row{issue: s, epic: epic})
+			}
+		}
+	}
+	return result
+}
+
+func (a *App) draw() {
+	height, width := a.term.size()
+	rows := a.rows()
+	a.cursor = max(0, min(a.cursor, len(rows)-1))
+	// Leave room for the headers and the two lines at the bottom.
+	header := 0
+	if a.showCal {
+		header = 2
+	}
+	visible := max(1, height-header-2)
+	if a.cursor < a.top {
+		a.top = a.cursor
+	}
+	if a.cursor >= a.top+visible {
+		a.top = a.cursor - visible + 1
+	}
+	var b strings.Builder
+	b.WriteString(ansiClear)
+	textWidth := width
+	if a.showCal {
+		textWidth = max(20, width-len(a.outer.MonthHeader())-2)
+		pad := strings.Repeat(" ", textWidth)
+		b.WriteString(pad + a.outer.MonthHeader() + "\r\n")
+		d1, _ := a.outer.DayHeaders()
+		b.WriteString(pad + d1 + "\r\n")
+	}
+	today := utils.Today()
+	for i := a.top; i < len(rows) && i < a.top+visible; i++ {
+		r := rows[i]
+		indent, mark := "  ", " "
+		if r.isEpic {
+			indent, mark = "", "+"
+			if a.expanded[r.issue.MyKey] {
+				mark = "-"
+			}
+		}
+		line := fmt.Sprintf("%s%s %-9s %-12s %s", indent, mark, r.issue.MyKey,
+			utils.Ellipsis(r.issue.StatusRaw(), 12), r.issue.MySummary())
+		line = fmt.Sprintf("%-*s", textWidth, utils.Ellipsis(line, textWidth-1))
+		if i == a.cursor {
+			line = ansiReverse + line + utils.TerminalReset
+		}
+		b.WriteString(line)
+		if a.showCal {
+			dr, err := utils.MakeDayRangeGentle(r.issue.DateStart(), r.issue.DateEnd())
+			if err != nil {
+				b.WriteString(a.outer.AsEmpty(today, "no dates", true))
+			} else {
+				b.WriteString(dr.AsIntersect(today, "", a.outer, true,
+					myj.StatusColor(r.issue, myj.ColorKindTerminal)))
+			}
+		}
+		b.WriteString("\r\n")
+	}
+	for i := len(rows) - a.top; i < visible; i++ {
+		b.WriteString("\r\n")
+	}
+	msg := utils.Ellipsis(a.msg, width-1)
+	if a.isErr {
+		b.WriteString(utils.TerminalColorRed + msg + utils.TerminalReset)
+	} else {
+		b.WriteString(msg)
+	}
+	b.WriteString("\r\n" + utils.TerminalColorGray +
+		utils.Ellipsis(help, width-1) + utils.TerminalReset)
+	_, _ = fmt.Fprint(a.out, b.String())
+}
+
+func (a *App) handle(key string) {
+	// These keys need no issue, so work even if there are none.
+	switch key {
+	case "c":
+		a.showCal = !a.showCal
+		return
+	case "g":
+		a.load()
+		a.msg = "reloaded"
+		return
+	}
+	rows := a.rows()
+	if len(rows) == 0 {
+		a.msg = "no epics"
+		return
+	}
+	r := rows[max(0, min(a.cursor, len(rows)-1))]
+	var err error
+	if strings.Contains("rdmsla", key) && r.issue.MyKey.Num >= myj.UnknownEpicBase {
+		a.msg, a.isErr = "that's a placeholder, not an issue", true
+		return
+	}
+	switch key {
+	case "j", keyDown:
+		a.cursor++
+	case "k", keyUp:
+		a.cursor--
+	case keyEnter, " ", keyRight, keyLeft:
+		if r.isEpic {
+			a.expanded[r.issue.MyKey] = key != keyLeft && !a.expanded[r.issue.MyKey]
+		}
+	case "r":
+		err = a.rename(r.issue)
+	case "d":
+		err = a.setDates(r.issue)
+	case "m":
+		err = a.move(r)
+	case "s":
+		err = a.setStatus(r.issue)
+	case "l":
+		err = a.label(r.issue)
+	case "a":
+		err = a.assign(r.issue)
+	default:
+		a.msg = "unknown key " + strconv.Quote(key)
+	}
+	if err != nil {
+		a.msg, a.isErr = err.Error(), true
+	}
+}
+
+// errCancelled means the user entered nothing at a prompt.
+var errCancelled = fmt.Errorf("cancelled")
+
+// prompt reads a line from the user in the terminal's usual mode.
+func (a *App) prompt(label string) (string, error) {
+	_, _ = fmt.Fprint(a.out, ansiShowCursor+"\r\n"+label+": ")
+	if err := a.term.restore(); err != nil {
+		return "", err
+	}
+	line, err := bufio.NewReader(a.term.tty).ReadString('\n')
+	if err2 := a.term.raw(); err == nil {
+		err = err2
+	}
+	_, _ = fmt.Fprint(a.out, ansiHideCursor)
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return "", errCancelled
+	}
+	return line, nil
+}
+
+// done reports a successful write, and reloads to show its effect.
+func (a *App) done(f string, args ...any) {
+	a.load()
+	a.msg = fmt.Sprintf(f, args...)
+}
+
+func (a *App) rename(issue *myj.ResponseIssue) error {
+	name, err := a.prompt(fmt.Sprintf("new summary for %s (was %q)",
+		issue.MyKey, issue.Fields.Summary))
+	if err != nil {
+		return err
+	}
+	if err = a.jb.RenameIssue(issue.MyKey.Num, name); err != nil {
+		return err
+	}
+	a.done("renamed %s", issue.MyKey)
+	return nil
+}
+
+func (a *App) setDates(issue *myj.ResponseIssue) error {
+	line, err := a.prompt(fmt.Sprintf("start and end for %s (was %s %s)",
+		issue.MyKey, issue.DateStart(), issue.DateEnd()))
+	if err != nil {
+		return err
+	}
+	f := strings.Fields(line)
+	if len(f) != 2 {
+		return fmt.Errorf("enter two dates, e.g. 2026-Oct-20 2026-Nov-30")
+	}
+	start, err := utils.ParseDate(f[0])
+	if err != nil {
+		return err
+	}
+	end, err := utils.ParseDate(f[1])
+	if err != nil {
+		return err
+	}
+	if end.Before(start) {
+		return fmt.Errorf("end %s precedes start %s", end, start)
+	}
+	if err = a.jb.SetDates(issue.MyKey.Num, start, end); err != nil {
+		return err
+	}
+	a.done("%s now runs %s to %s", issue.MyKey, start, end)
+	return nil
+}
+
+func (a *App) move(r row) error {
+	if r.isEpic {
+		return fmt.Errorf("only stories can move between epics")
+	}
+	line, err := a.prompt(fmt.Sprintf("move %s to epic number (0 for none)", r.issue.MyKey))
+	if err != nil {
+		return err
+	}
+	epic, err := strconv.Atoi(line)
+	if err != nil {
+		return fmt.Errorf("%q is not a number", line)
+	}
+	if epic == 0 {
+		err = a.jb.ClearEpicLink(r.issue.MyKey.Num)
+	} else {
+		err = a.jb.SetEpicLink(r.issue.MyKey.Num, epic)
+	}
+	if err != nil {
+		return err
+	}
+	a.done("moved %s", r.issue.MyKey)
+	return nil
+}
+
+func (a *App) setStatus(issue *myj.ResponseIssue) error {
+	catalog, err := a.jb.GetCatalog()
+	if err != nil {
+		return err
+	}
+	line, err := a.prompt(fmt.Sprintf("new status for %s (was %s)",
+		issue.MyKey, issue.StatusRaw()))
+	if err != nil {
+		return err
+	}
+	info, ok := catalog.Status(line)
+	if !ok {
+		return fmt.Errorf("unknown status %q", line)
+	}
+	path, err := a.jb.FindTransitionPath(issue.MyKey.Num, info.Name)
+	if err != nil {
+		return err
+	}
+	if err = a.jb.MoveIssueAlongPath(
+		issue.MyKey.Num, path, &myj.TransitionOptions{}); err != nil {
+		return err
+	}
+	a.done("%s is now %s", issue.MyKey, info.Name)
+	return nil
+}
+
+func (a *App) label(issue *myj.ResponseIssue) error {
+	line, err := a.prompt(fmt.Sprintf("label to add to %s, or -label to remove (has %v)",
+		issue.MyKey, issue.Fields.Labels))
+	if err != nil {
+		return err
+	}
+	remove := strings.HasPrefix(line, "-")
+	label := strings.TrimPrefix(line, "-")
+	if err = a.jb.LabelIssues(label, []int{issue.MyKey.Num}, remove); err != nil {
+		return err
+	}
+	a.done("labeled %s", issue.MyKey)
+	return nil
+}
+
+func (a *App) assign(issue *myj.ResponseIssue) error {
+	line, err := a.prompt(fmt.Sprintf("assignee for %s, or - for none (was %q)",
+		issue.MyKey, issue.AssigneeLdap()))
+	if err != nil {
+		return err
+	}
+	if line == "-" {
+		line = ""
+	}
+	if err = a.jb.AssignIssues([]int{issue.MyKey.Num}, line); err != nil {
+		return err
+	}
+	a.done("assigned %s", issue.MyKey)
+	return nil
+}
@@ -0,0 +1,24 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureStderr(t *testing.T) {
+	saved := os.Stderr
+	logged := captureStderr(func() {
+		_, _ = fmt.Fprintln(os.Stderr, "Would PUT rest/api/2/issue/BUDS-1")
+		_, _ = fmt.Fprintln(os.Stderr, `  {"fields": {}}`)
+		_, _ = fmt.Fprintln(os.Stderr, "")
+		_, _ = fmt.Fprintln(os.Stderr, "\tstep")
+		_, _ = fmt.Fprintln(os.Stderr, "1 now blocks [2]")
+	})
+	assert.Same(t, saved, os.Stderr)
+	assert.Equal(t,
+		"Would PUT rest/api/2/issue/BUDS-1; 1 now blocks [2]", logMessage(logged))
+	assert.Equal(t, "", logMessage(captureStderr(func() {})))
+}
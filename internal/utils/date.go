@@ -15,6 +15,9 @@ const (
 	DayFormatHuman  = "2006-Jan-02"
 	DayFormatJira   = "2006-01-02"
 	DayFormatHuman2 = "2006-Jan-2"
+
+	// TimestampFormatJira is how the v2 API writes timestamps.
+	TimestampFormatJira = "2006-01-02T15:04:05.000-0700"
 )
 
 func AllDateFormats() []string {
@@ -187,6 +190,30 @@ func FromJiraOrDie(f string) Date {
 	return d
 }
 
+// ParseTimestamp returns the Date of a timestamp like those found in
+// the created, updated and resolutiondate fields, ignoring the time of day.
+func ParseTimestamp(v string) (Date, error) {
+	for _, f := range []string{TimestampFormatJira, time.RFC3339} {
+		if t, err := time.Parse(f, v); err == nil {
+			return fromTimeTrunc(t), nil
+		}
+	}
+	return Today(), fmt.Errorf("bad timestamp value %q", v)
+}
+
+// FromTimestampOrEpic returns a Date parsed from a jira timestamp, or
+// GoEpicDate if the timestamp is missing or malformed.
+func FromTimestampOrEpic(f string) Date {
+	if f == "" {
+		return GoEpicDate
+	}
+	d, err := ParseTimestamp(f)
+	if err != nil {
+		return GoEpicDate
+	}
+	return d
+}
+
 // Format uses a time format.
 func (d Date) Format(f string) string {
 	return d.ts.Format(f)
@@ -174,6 +174,33 @@ func Test_FromTimeRounded(t *testing.T) {
 	}
 }
 
+func Test_ParseTimestamp(t *testing.T) {
+	type testCase struct {
+		ts       string
+		expected Date
+	}
+	tests := map[string]testCase{
+		"v2": {
+			ts:       "2025-12-20T23:51:31.000+0000",
+			expected: MakeDate(2025, time.December, 20),
+		},
+		"agile": {
+			ts:       "2025-04-11T15:22:00.000+10:00",
+			expected: MakeDate(2025, time.April, 11),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := ParseTimestamp(tc.ts)
+			assert.NoError(t, err)
+			assert.True(t, tc.expected.Equal(d))
+		})
+	}
+	_, err := ParseTimestamp("2025-12-20")
+	assert.Error(t, err)
+	assert.True(t, FromTimestampOrEpic("").IsGoEpic())
+}
+
 func makeTime(year int, m time.Month, day, hour, min int) time.Time {
 	return time.Date(year, m, day, hour, min, 0, 0, time.UTC)
 }
@@ -112,6 +112,23 @@ func (dr *DayRange) Contains(d Date) bool {
 	return d.After(start) && d.Before(end)
 }
 
+// ContainsRange is true if every day of the argument is in this range.
+func (dr *DayRange) ContainsRange(other *DayRange) bool {
+	return dr.Contains(other.Start()) && dr.Contains(other.End())
+}
+
+// Union returns the smallest range holding both this and the argument.
+func (dr *DayRange) Union(other *DayRange) *DayRange {
+	start, end := dr.Start(), dr.End()
+	if other.Start().Before(start) {
+		start = other.Start()
+	}
+	if other.End().After(end) {
+		end = other.End()
+	}
+	return &DayRange{date: start, dayCount: start.DayCount(end)}
+}
+
 // StartsBefore is true if the argument strictly starts before this
 func (dr *DayRange) StartsBefore(other *DayRange) bool {
 	return dr.Start().Before(other.Start())
@@ -336,6 +353,50 @@ func (dr *DayRange) AsIntersect(
 	return b.String()
 }
 
+// AsEmpty returns a string the same width as AsIntersect, but with no
+// days marked (aside from today), and the given note written near the start.
+// It's meant for things that have no dates, so it's all gray.
+func (dr *DayRange) AsEmpty(today Date, note string, useColor bool) string {
+	outer := dr.RoundToMondayAndFriday()
+	todaySymbol := plusSign
+	if useColor {
+		todaySymbol = circleClosed
+	}
+	result := []rune{vertBar}
+	outDay := outer.Start().AddDays(-1)
+	var newWeekend = false
+	for i := 0; i < outer.dayCount; i++ {
+		outDay = outDay.AddDays(1)
+		if outDay.IsWeekend() {
+			newWeekend = !newWeekend
+			if newWeekend {
+				if today == outDay || today == outDay.AddDays(1) {
+					result = append(result, todaySymbol)
+				} else {
+					result = append(result, vertBar)
+				}
+			}
+			continue
+		}
+		if outDay == today {
+			result = append(result, todaySymbol)
+		} else {
+			result = append(result, emptySpace)
+		}
+	}
+	result = append(result, vertBar)
+	// Overlay the note on the days following the first separator.
+	for i, r := range []rune(note) {
+		if i+2 < len(result) {
+			result[i+1] = r
+		}
+	}
+	if useColor {
+		return TerminalColorGray + string(result) + TerminalReset
+	}
+	return string(result)
+}
+
 func (dr *DayRange) MonthHeader() string {
 	outer := dr.RoundToMondayAndFriday()
 	var b bytes.Buffer
@@ -409,3 +470,51 @@ func (dr *DayRange) DayHeaders() (string, string) {
 	b2.WriteByte(emptySpace)
 	return b1.String(), b2.String()
 }
+
+// Column returns the index of the character representing the given day
+// in the strings made by AsIntersect, MonthHeader and DayHeaders, or -1
+// if the day isn't in the (rounded) range.  Both days of a weekend
+// share one column.
+func (dr *DayRange) Column(d Date) int {
+	outer := dr.RoundToMondayAndFriday()
+	outDay := outer.Start().AddDays(-1)
+	var newWeekend = false
+	col := 0
+	for i := 0; i < outer.dayCount; i++ {
+		outDay = outDay.AddDays(1)
+		if outDay.IsWeekend() {
+			newWeekend = !newWeekend // only 2 days in a weekend
+			if newWeekend {
+				col++
+			}
+		} else {
+			col++
+		}
+		if outDay.Equal(d) {
+			return col
+		}
+	}
+	return -1
+}
+
+// MarkBoundaries returns a copy of the given row (e.g. a header made by
+// MonthHeader or DayHeaders) with a vertical bar in the column just before
+// the start, and just after the end, of each of the given ranges.
+// Only blank columns are marked, so the header text remains legible.
+func (dr *DayRange) MarkBoundaries(row string, ranges []*DayRange) string {
+	runes := []rune(row)
+	mark := func(col int) {
+		if col >= 0 && col < len(runes) && runes[col] == emptySpace {
+			runes[col] = vertBar
+		}
+	}
+	for _, r := range ranges {
+		if c := dr.Column(r.Start()); c > 0 {
+			mark(c - 1)
+		}
+		if c := dr.Column(r.End()); c > 0 {
+			mark(c + 1)
+		}
+	}
+	return string(runes)
+}
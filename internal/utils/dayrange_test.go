@@ -409,3 +409,52 @@ func TestDayRange_EndAsTime(t *testing.T) {
 		})
 	}
 }
+
+func Test_MarkBoundaries(t *testing.T) {
+	outer, err := MakeRangeFromStringPair("2025-Mar-30:2025-Apr-24")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sprint, err := MakeRangeFromStringPair("2025-Apr-07:2025-Apr-18")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	h1, h2 := outer.DayHeaders()
+	assert.Equal(t,
+		"  April           │     ",
+		outer.MarkBoundaries(outer.MonthHeader(), []*DayRange{sprint}))
+	assert.Equal(t,
+		" 3_   │   1       │2     ",
+		outer.MarkBoundaries(h1, []*DayRange{sprint}))
+	assert.Equal(t,
+		" 11234│78901 45678│12345 ",
+		outer.MarkBoundaries(h2, []*DayRange{sprint}))
+}
+
+func Test_AsEmpty(t *testing.T) {
+	outer, err := MakeRangeFromStringPair("2025-Mar-30:2025-Apr-24")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	today, err := ParseDate("2025-Apr-09")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	assert.Equal(t,
+		"│no dates+  │     │     │",
+		outer.AsEmpty(today, "no dates", false))
+}
+
+func Test_UnionAndContainsRange(t *testing.T) {
+	a, err := MakeRangeFromStringPair("2025-Apr-01:2025-Apr-10")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	b, err := MakeRangeFromStringPair("2025-Apr-08:2025-Apr-20")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	assert.Equal(t, "2025-Apr-01:2025-Apr-20", a.Union(b).String())
+	assert.False(t, a.ContainsRange(b))
+	assert.True(t, a.Union(b).ContainsRange(b))
+}
@@ -0,0 +1,44 @@
+package utils
+
+// DiffLines returns the lines removed from before (prefixed "- ") and
+// added in after (prefixed "+ "), in order, using a longest common
+// subsequence.  Unchanged lines are omitted.
+func DiffLines(before, after []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence
+	// of before[i:] and after[j:].
+	lcs := make([][]int, len(before)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] == after[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var result []string
+	i, j := 0, 0
+	for i < len(before) && j < len(after) {
+		switch {
+		case before[i] == after[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, "- "+before[i])
+			i++
+		default:
+			result = append(result, "+ "+after[j])
+			j++
+		}
+	}
+	for ; i < len(before); i++ {
+		result = append(result, "- "+before[i])
+	}
+	for ; j < len(after); j++ {
+		result = append(result, "+ "+after[j])
+	}
+	return result
+}
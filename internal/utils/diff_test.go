@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := map[string]struct {
+		before []string
+		after  []string
+		want   []string
+	}{
+		"same": {
+			before: []string{"a", "b"},
+			after:  []string{"a", "b"},
+		},
+		"empty": {
+			after: []string{"a"},
+			want:  []string{"+ a"},
+		},
+		"changed": {
+			before: []string{"a", "b", "c"},
+			after:  []string{"a", "x", "c"},
+			want:   []string{"- b", "+ x"},
+		},
+		"moved": {
+			before: []string{"a", "b", "c", "d"},
+			after:  []string{"a", "c", "d", "b"},
+			want:   []string{"- b", "+ b"},
+		},
+		"removed at end": {
+			before: []string{"a", "b"},
+			after:  []string{"a"},
+			want:   []string{"- b"},
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, DiffLines(tc.before, tc.after))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// EditorCommand returns the user's editor, from $VISUAL or $EDITOR.
+func EditorCommand() string {
+	for _, v := range []string{"VISUAL", "EDITOR"} {
+		if e := os.Getenv(v); e != "" {
+			return e
+		}
+	}
+	return "vi"
+}
+
+// EditText lets the user edit the given text in their editor, and
+// returns the result.  The suffix (e.g. ".txt") names the temp file,
+// which may give the editor a hint about syntax.
+func EditText(initial, suffix string) (string, error) {
+	f, err := os.CreateTemp("", "gojira-*"+suffix)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err = f.WriteString(initial); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	if err = f.Close(); err != nil {
+		return "", err
+	}
+	// The editor may have arguments, e.g. "code --wait".
+	args := strings.Fields(EditorCommand())
+	cmd := exec.Command(args[0], append(args[1:], f.Name())...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err = cmd.Run(); err != nil {
+		return "", fmt.Errorf("running editor %q; %w", args[0], err)
+	}
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ReadText returns arg, unless it's "-", in which case it reads
+// stdin, or empty, in which case the user writes it in their editor
+// starting from the given text.  Surrounding whitespace is trimmed,
+// and empty results are an error.
+func ReadText(arg, initial string) (string, error) {
+	var err error
+	switch arg {
+	case "-":
+		var data []byte
+		data, err = io.ReadAll(os.Stdin)
+		arg = string(data)
+	case "":
+		arg, err = EditText(initial, ".txt")
+	}
+	if err != nil {
+		return "", err
+	}
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return "", fmt.Errorf("no text given")
+	}
+	return arg, nil
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"strings"
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline draws the last width values as a row of bars, each scaled
+// against the largest value.  Values of zero or less get the lowest bar.
+// The result is always width runes, padded on the left with spaces.
+func Sparkline(values []int, width int) string {
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	biggest := 0
+	for _, v := range values {
+		biggest = max(biggest, v)
+	}
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", width-len(values)))
+	top := len(sparks) - 1
+	for _, v := range values {
+		if v <= 0 {
+			b.WriteRune(sparks[0])
+			continue
+		}
+		// Ceiling division, so that any positive value shows.
+		b.WriteRune(sparks[(v*top+biggest-1)/biggest])
+	}
+	return b.String()
+}
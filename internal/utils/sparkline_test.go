@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		width  int
+		want   string
+	}{
+		"empty": {
+			width: 3,
+			want:  "   ",
+		},
+		"padded": {
+			values: []int{1, 2},
+			width:  4,
+			want:   "  ▅█",
+		},
+		"scaled": {
+			values: []int{0, 1, 7, 14, -3},
+			width:  5,
+			want:   "▁▂▅█▁",
+		},
+		"truncated": {
+			values: []int{9, 9, 1, 2},
+			width:  2,
+			want:   "▅█",
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, tc.want, Sparkline(tc.values, tc.width))
+		})
+	}
+}